@@ -38,8 +38,22 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		}, nil
 	}
 
+	initFactory := func() (cli.Command, error) {
+		return &cmd.InitCommand{
+			UI: ui,
+		}, nil
+	}
+
+	validateFactory := func() (cli.Command, error) {
+		return &cmd.ValidateCommand{
+			UI: ui,
+		}, nil
+	}
+
 	return map[string]cli.CommandFactory{
 		"generate": generateFactory,
+		"init":     initFactory,
+		"validate": validateFactory,
 	}
 }
 
@@ -14,12 +14,15 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 
 	"github.com/hashicorp/cli"
 	"github.com/pb33f/libopenapi"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/index"
 )
 
@@ -28,21 +31,33 @@ type GenerateCommand struct {
 	oasInputPath   string
 	flagConfigPath string
 	flagOutputPath string
+	flagStrict     bool
+
+	flagReportPath   string
+	flagReportFormat string
 }
 
 func (cmd *GenerateCommand) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
 	fs.StringVar(&cmd.flagConfigPath, "config", "./generator_config.yml", "path to generator config file (YAML)")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./provider_code_spec.json", "destination file path for generated provider code spec (JSON)")
+	fs.BoolVar(&cmd.flagStrict, "strict", false, "fail on skipped resources, data sources, and attributes, or an invalid provider code spec")
+	fs.StringVar(&cmd.flagReportPath, "report", "", "destination file path for a report of all warnings and errors")
+	fs.StringVar(&cmd.flagReportFormat, "report-format", reportFormatJSON, "format of the report file, either \"json\" or \"sarif\" (SARIF 2.1.0)")
 	return fs
 }
 
 func (cmd *GenerateCommand) Help() string {
+	return commandHelp("tfplugingen-openapi generate [<args>] </path/to/oas_file.yml>", cmd.Flags())
+}
+
+// commandHelp returns the usage of a command, followed by every flag with their usage and default value.
+func commandHelp(usage string, fs *flag.FlagSet) string {
 	strBuilder := &strings.Builder{}
 
 	longestName := 0
 	longestUsage := 0
-	cmd.Flags().VisitAll(func(f *flag.Flag) {
+	fs.VisitAll(func(f *flag.Flag) {
 		if len(f.Name) > longestName {
 			longestName = len(f.Name)
 		}
@@ -51,9 +66,15 @@ func (cmd *GenerateCommand) Help() string {
 		}
 	})
 
-	strBuilder.WriteString("\nUsage: tfplugingen-openapi generate [<args>] </path/to/oas_file.yml>\n\n")
-	cmd.Flags().VisitAll(func(f *flag.Flag) {
-		if f.DefValue != "" {
+	strBuilder.WriteString(fmt.Sprintf("\nUsage: %s\n\n", usage))
+	fs.VisitAll(func(f *flag.Flag) {
+		if isBoolFlag(f) {
+			strBuilder.WriteString(fmt.Sprintf("    --%s       %s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+			))
+		} else if f.DefValue != "" {
 			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
 				f.Name,
 				strings.Repeat(" ", longestName-len(f.Name)+2),
@@ -75,14 +96,25 @@ func (cmd *GenerateCommand) Help() string {
 	return strBuilder.String()
 }
 
+// isBoolFlag returns true if the flag doesn't require an argument, like `--strict`.
+func isBoolFlag(f *flag.Flag) bool {
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && boolFlag.IsBoolFlag()
+}
+
 func (cmd *GenerateCommand) Synopsis() string {
 	return "Generates Provider Code Specification from an OpenAPI 3.x Specification"
 }
 
 func (cmd *GenerateCommand) Run(args []string) int {
-	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelWarn,
-	}))
+	// All warnings and errors are collected, so they can be written to a report file
+	diagnostics := &log.Diagnostics{}
+	logger := slog.New(log.NewDiagnosticsHandler(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			Level: slog.LevelWarn,
+		}),
+		diagnostics,
+	))
 
 	fs := cmd.Flags()
 	err := fs.Parse(args)
@@ -97,9 +129,27 @@ func (cmd *GenerateCommand) Run(args []string) int {
 		return 1
 	}
 
+	err = validateReportFormat(cmd.flagReportFormat)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
 	err = cmd.runInternal(logger)
 	if err != nil {
 		logger.Error("error executing command", "err", err)
+	}
+
+	// The report is written even if the command failed, as it contains the diagnostics explaining why
+	if cmd.flagReportPath != "" {
+		reportErr := writeReport(cmd.flagReportPath, cmd.flagReportFormat, cmd.oasInputPath, diagnostics.All())
+		if reportErr != nil {
+			logger.Error("error writing report", "err", reportErr)
+			return 1
+		}
+	}
+
+	if err != nil {
 		return 1
 	}
 
@@ -117,57 +167,55 @@ func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
 		return fmt.Errorf("error parsing generator config file: %w", err)
 	}
 
-	// 2. Read and parse OpenAPI spec file
-	oasBytes, err := os.ReadFile(cmd.oasInputPath)
-	if err != nil {
-		return fmt.Errorf("error reading OpenAPI spec file: %w", err)
-	}
-	doc, err := libopenapi.NewDocument(oasBytes)
-	if err != nil {
-		return fmt.Errorf("error parsing OpenAPI spec file: %w", err)
-	}
-
-	// 3. Build out the OpenAPI model, this will recursively load all local + remote references into one cohesive model
-	model, errs := doc.BuildV3Model()
-
-	// 4. Log circular references as warnings and fail on any other model building errors
-	var errResult error
-	for _, err := range errs {
-		if rslvErr, ok := err.(*index.ResolvingError); ok {
-			logger.Warn(
-				"circular reference found in OpenAPI spec",
-				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
-			continue
-		}
+	// Silence all warnings that are suppressed in the generator config
+	logger = slog.New(log.NewSuppressHandler(logger.Handler(), func(diag log.Diagnostic) bool {
+		return config.IsSuppressed(string(diag.Code), diag.Resource, diag.DataSource, diag.AttributePath)
+	}))
 
-		errResult = errors.Join(errResult, err)
-	}
-	if errResult != nil {
-		return fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
+	// 2. Read, parse, and build the OpenAPI model
+	model, err := buildOpenAPIModel(logger, cmd.oasInputPath)
+	if err != nil {
+		return err
 	}
 
-	// 5. Generate provider code spec w/ config
-	oasExplorer := explorer.NewConfigExplorer(model.Model, *config)
-	providerCodeSpec, err := generateProviderCodeSpec(logger, oasExplorer, *config)
+	// 3. Generate provider code spec w/ config
+	oasExplorer := explorer.NewConfigExplorer(*model, *config)
+	providerCodeSpec, strictErrs, err := generateProviderCodeSpec(logger, oasExplorer, *config, mapper.Options{Strict: cmd.flagStrict})
 	if err != nil {
 		return err
 	}
 
-	// 6. Use provider code spec to create JSON
+	// 4. Use provider code spec to create JSON
 	bytes, err := json.MarshalIndent(providerCodeSpec, "", "\t")
 	if err != nil {
 		return fmt.Errorf("error marshalling provider code spec to JSON: %w", err)
 	}
 
-	// 7. Log a warning if the provider code spec is not valid based on the JSON schema
+	// 5. Log a warning if the provider code spec is not valid based on the JSON schema, which is an error in strict mode
 	err = spec.Validate(context.TODO(), bytes)
 	if err != nil {
-		logger.Warn(
-			"generated provider code spec failed validation",
-			"validation_msg", err)
+		if cmd.flagStrict {
+			logger.Error(
+				"generated provider code spec failed validation",
+				"code", diagnostic.CodeInvalidProviderCodeSpec,
+				"validation_msg", err)
+			strictErrs = append(strictErrs, fmt.Errorf("generated provider code spec failed validation: %w", err))
+		} else {
+			logger.Warn(
+				"generated provider code spec failed validation",
+				"code", diagnostic.CodeInvalidProviderCodeSpec,
+				"validation_msg", err)
+		}
+	}
+
+	// 6. In strict mode, summarize everything that was skipped and fail before writing any output
+	if len(strictErrs) > 0 {
+		cmd.UI.Error(strictSummary(strictErrs))
+
+		return fmt.Errorf("strict mode: %d problem(s) found, provider code spec was not written", len(strictErrs))
 	}
 
-	// 8. Output to file
+	// 7. Output to file
 	output, err := os.Create(cmd.flagOutputPath)
 	if err != nil {
 		return fmt.Errorf("error creating output file for provider code spec: %w", err)
@@ -181,44 +229,86 @@ func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
 	return nil
 }
 
-func generateProviderCodeSpec(logger *slog.Logger, dora explorer.Explorer, cfg config.Config) (*spec.Specification, error) {
+// buildOpenAPIModel reads and parses an OpenAPI spec file, then builds the OpenAPI model, which will recursively load all local and
+// remote references into one cohesive model. Circular references are logged as warnings, all other errors are returned.
+func buildOpenAPIModel(logger *slog.Logger, oasPath string) (*high.Document, error) {
+	oasBytes, err := os.ReadFile(oasPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading OpenAPI spec file: %w", err)
+	}
+	doc, err := libopenapi.NewDocument(oasBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+
+	var errResult error
+	for _, err := range errs {
+		if rslvErr, ok := err.(*index.ResolvingError); ok {
+			logger.Warn(
+				"circular reference found in OpenAPI spec",
+				"code", diagnostic.CodeCircularReference,
+				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
+			continue
+		}
+
+		errResult = errors.Join(errResult, err)
+	}
+	if errResult != nil {
+		return nil, fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
+	}
+
+	return &model.Model, nil
+}
+
+// generateProviderCodeSpec maps all resources, data sources, and the provider to a provider code spec. In strict mode, all skipped
+// resources and data sources are returned as separate errors, so they can be summarized together.
+func generateProviderCodeSpec(logger *slog.Logger, dora explorer.Explorer, cfg config.Config, opts mapper.Options) (*spec.Specification, []error, error) {
 	// 1. Find TF resources in OAS
 	explorerResources, err := dora.FindResources()
 	if err != nil {
-		return nil, fmt.Errorf("error finding resource(s): %w", err)
+		return nil, nil, fmt.Errorf("error finding resource(s): %w", err)
 	}
 
 	// 2. Find TF data sources in OAS
 	explorerDataSources, err := dora.FindDataSources()
 	if err != nil {
-		return nil, fmt.Errorf("error finding data source(s): %w", err)
+		return nil, nil, fmt.Errorf("error finding data source(s): %w", err)
 	}
 
 	// 3. Find TF provider in OAS
 	explorerProvider, err := dora.FindProvider()
 	if err != nil {
-		return nil, fmt.Errorf("error finding provider: %w", err)
+		return nil, nil, fmt.Errorf("error finding provider: %w", err)
 	}
 
 	// 4. Use TF info to generate provider code spec for resources
-	resourceMapper := mapper.NewResourceMapper(explorerResources, cfg)
+	var strictErrs []error
+	resourceMapper := mapper.NewResourceMapper(explorerResources, cfg, opts)
 	resourcesIR, err := resourceMapper.MapToIR(logger)
 	if err != nil {
-		return nil, fmt.Errorf("error generating provider code spec for resources: %w", err)
+		if !opts.Strict {
+			return nil, nil, fmt.Errorf("error generating provider code spec for resources: %w", err)
+		}
+		strictErrs = append(strictErrs, unwrapJoined(err)...)
 	}
 
 	// 5. Use TF info to generate provider code spec for data sources
-	dataSourceMapper := mapper.NewDataSourceMapper(explorerDataSources, cfg)
+	dataSourceMapper := mapper.NewDataSourceMapper(explorerDataSources, cfg, opts)
 	dataSourcesIR, err := dataSourceMapper.MapToIR(logger)
 	if err != nil {
-		return nil, fmt.Errorf("error generating provider code spec for data sources: %w", err)
+		if !opts.Strict {
+			return nil, nil, fmt.Errorf("error generating provider code spec for data sources: %w", err)
+		}
+		strictErrs = append(strictErrs, unwrapJoined(err)...)
 	}
 
 	// 6. Use TF info to generate provider code spec for provider
-	providerMapper := mapper.NewProviderMapper(explorerProvider, cfg)
+	providerMapper := mapper.NewProviderMapper(explorerProvider, cfg, opts)
 	providerIR, err := providerMapper.MapToIR(logger)
 	if err != nil {
-		return nil, fmt.Errorf("error generating provider code spec for provider: %w", err)
+		return nil, nil, fmt.Errorf("error generating provider code spec for provider: %w", err)
 	}
 
 	return &spec.Specification{
@@ -226,5 +316,39 @@ func generateProviderCodeSpec(logger *slog.Logger, dora explorer.Explorer, cfg c
 		Provider:    providerIR,
 		Resources:   resourcesIR,
 		DataSources: dataSourcesIR,
-	}, nil
+	}, strictErrs, nil
+}
+
+// unwrapJoined returns all errors that were joined together with errors.Join, including errors joined within joined errors, or the
+// error itself if it wasn't joined.
+func unwrapJoined(err error) []error {
+	joinedErr, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+
+	errs := make([]error, 0)
+	for _, e := range joinedErr.Unwrap() {
+		errs = append(errs, unwrapJoined(e)...)
+	}
+
+	return errs
+}
+
+// strictSummary returns a summary of every problem that failed the generate command in strict mode.
+func strictSummary(strictErrs []error) string {
+	return problemSummary("Strict mode summary", strictErrs)
+}
+
+// problemSummary returns a heading with the number of problems found, followed by every problem.
+func problemSummary(heading string, problems []error) string {
+	strBuilder := &strings.Builder{}
+
+	strBuilder.WriteString(fmt.Sprintf("%s: %d problem(s) found\n", heading, len(problems)))
+	for _, err := range problems {
+		// Joined errors are written on separate lines, which are indented under the problem
+		strBuilder.WriteString(fmt.Sprintf("  - %s\n", strings.ReplaceAll(err.Error(), "\n", "\n    ")))
+	}
+
+	return strBuilder.String()
 }
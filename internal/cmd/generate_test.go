@@ -4,8 +4,10 @@
 package cmd_test
 
 import (
+	"encoding/json"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -83,3 +85,287 @@ func TestGenerate_WithConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerate_Strict(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath      string
+		configPath       string
+		expectedExitCode int
+		expectedSummary  []string
+	}{
+		"no problems": {
+			oasSpecPath:      "testdata/petstore3/openapi_spec.json",
+			configPath:       "testdata/petstore3/generator_config.yml",
+			expectedExitCode: 0,
+		},
+		"skipped resource": {
+			oasSpecPath:      "testdata/edgecase/openapi_spec.yml",
+			configPath:       "testdata/edgecase/generator_config.yml",
+			expectedExitCode: 1,
+			expectedSummary: []string{
+				"Strict mode summary: 1 problem(s) found",
+				`  - skipped resource "best_effort_test": '!!!' cannot be converted to a valid Terraform identifier`,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempProviderSpecPath := path.Join(t.TempDir(), "provider_code_spec.json")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.GenerateCommand{UI: mockUi}
+			args := []string{
+				"--config", testCase.configPath,
+				"--output", tempProviderSpecPath,
+				"--strict",
+				testCase.oasSpecPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			var gotSummary []string
+			if summary := strings.TrimSpace(mockUi.ErrorWriter.String()); summary != "" {
+				gotSummary = strings.Split(summary, "\n")
+			}
+
+			if diff := cmp.Diff(gotSummary, testCase.expectedSummary); diff != "" {
+				t.Errorf("unexpected difference in summary: %s", diff)
+			}
+
+			_, err := os.Stat(tempProviderSpecPath)
+			if testCase.expectedExitCode != 0 && !os.IsNotExist(err) {
+				t.Errorf("expected provider code spec to not be written, got: %v", err)
+			}
+			if testCase.expectedExitCode == 0 && err != nil {
+				t.Errorf("expected provider code spec to be written, got: %s", err)
+			}
+		})
+	}
+}
+
+func TestGenerate_Report(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		reportFormat string
+		strict       bool
+		expected     map[string]any
+	}{
+		"json": {
+			reportFormat: "json",
+			expected: map[string]any{
+				"severity":       "warning",
+				"code":           "invalid_identifier",
+				"resource":       "best_effort_test",
+				"attribute_path": "settings.!!!",
+				"file":           "testdata/edgecase/openapi_spec.yml",
+				"line":           float64(510),
+				"column":         float64(15),
+				"json_pointer":   "#/components/schemas/best_effort_schema/properties/settings/properties/!!!",
+				"excerpt":        "description: This property name can't be converted to a Terraform identifier",
+				"message":        "dropping attribute from schema: '!!!' cannot be converted to a valid Terraform identifier",
+			},
+		},
+		"json - strict": {
+			reportFormat: "json",
+			strict:       true,
+			expected: map[string]any{
+				"severity":       "error",
+				"code":           "invalid_identifier",
+				"resource":       "best_effort_test",
+				"attribute_path": "settings.!!!",
+				"file":           "testdata/edgecase/openapi_spec.yml",
+				"line":           float64(510),
+				"column":         float64(15),
+				"json_pointer":   "#/components/schemas/best_effort_schema/properties/settings/properties/!!!",
+				"excerpt":        "description: This property name can't be converted to a Terraform identifier",
+				"message":        "skipping resource schema mapping: '!!!' cannot be converted to a valid Terraform identifier",
+			},
+		},
+		"sarif": {
+			reportFormat: "sarif",
+			expected: map[string]any{
+				"ruleId": "invalid_identifier",
+				"level":  "warning",
+				"properties": map[string]any{
+					"jsonPointer": "#/components/schemas/best_effort_schema/properties/settings/properties/!!!",
+				},
+				"message": map[string]any{
+					"text": "dropping attribute from schema: '!!!' cannot be converted to a valid Terraform identifier",
+				},
+				"locations": []any{
+					map[string]any{
+						"physicalLocation": map[string]any{
+							"artifactLocation": map[string]any{"uri": "testdata/edgecase/openapi_spec.yml"},
+							"region": map[string]any{
+								"startLine":   float64(510),
+								"startColumn": float64(15),
+								"snippet":     map[string]any{"text": "description: This property name can't be converted to a Terraform identifier"},
+							},
+						},
+						"logicalLocations": []any{
+							map[string]any{
+								"fullyQualifiedName": "resource.best_effort_test.settings.!!!",
+								"kind":               "member",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+			reportPath := path.Join(tempDir, "report")
+
+			c := cmd.GenerateCommand{UI: cli.NewMockUi()}
+			args := []string{
+				"--config", "testdata/edgecase/generator_config.yml",
+				"--output", path.Join(tempDir, "provider_code_spec.json"),
+				"--report", reportPath,
+				"--report-format", testCase.reportFormat,
+			}
+			if testCase.strict {
+				args = append(args, "--strict")
+			}
+			args = append(args, "testdata/edgecase/openapi_spec.yml")
+
+			c.Run(args)
+
+			reportBytes, err := os.ReadFile(reportPath)
+			if err != nil {
+				t.Fatalf("error reading report: %s", err)
+			}
+
+			var report map[string]any
+			err = json.Unmarshal(reportBytes, &report)
+			if err != nil {
+				t.Fatalf("error unmarshalling report: %s", err)
+			}
+
+			var entries []any
+			if testCase.reportFormat == "sarif" {
+				if report["version"] != "2.1.0" {
+					t.Errorf("expected SARIF version 2.1.0, got: %v", report["version"])
+				}
+				entries, _ = report["runs"].([]any)[0].(map[string]any)["results"].([]any)
+			} else {
+				entries, _ = report["diagnostics"].([]any)
+			}
+
+			// Diagnostics are logged in mapping order, so the first one is from the first resource
+			if len(entries) == 0 {
+				t.Fatalf("expected report to contain diagnostics, got: %s", reportBytes)
+			}
+
+			if diff := cmp.Diff(entries[0], any(testCase.expected)); diff != "" {
+				t.Errorf("unexpected difference in first diagnostic: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerate_ReportSuppress(t *testing.T) {
+	t.Parallel()
+
+	// The read response_path doesn't exist in the petstore3 read response body
+	config := `
+provider:
+  name: petstore
+
+resources:
+  pet:
+    create:
+      path: /pet
+      method: POST
+    read:
+      path: /pet/{petId}
+      method: GET
+      response_path: data.item
+`
+
+	testCases := map[string]struct {
+		suppress     string
+		expectedCode bool
+	}{
+		"not suppressed": {
+			expectedCode: true,
+		},
+		"suppressed": {
+			suppress: `
+suppress:
+  - code: invalid_schema_path
+`,
+			expectedCode: false,
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+			configPath := path.Join(tempDir, "generator_config.yml")
+			err := os.WriteFile(configPath, []byte(config+testCase.suppress), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reportPath := path.Join(tempDir, "report")
+
+			c := cmd.GenerateCommand{UI: cli.NewMockUi()}
+			c.Run([]string{
+				"--config", configPath,
+				"--output", path.Join(tempDir, "provider_code_spec.json"),
+				"--report", reportPath,
+				"testdata/petstore3/openapi_spec.json",
+			})
+
+			reportBytes, err := os.ReadFile(reportPath)
+			if err != nil {
+				t.Fatalf("error reading report: %s", err)
+			}
+
+			var report map[string]any
+			err = json.Unmarshal(reportBytes, &report)
+			if err != nil {
+				t.Fatalf("error unmarshalling report: %s", err)
+			}
+
+			var found map[string]any
+			entries, _ := report["diagnostics"].([]any)
+			for _, entry := range entries {
+				diag, _ := entry.(map[string]any)
+				if diag["code"] == "invalid_schema_path" {
+					found = diag
+				}
+			}
+
+			if !testCase.expectedCode {
+				if found != nil {
+					t.Fatalf("expected invalid_schema_path diagnostic to be suppressed, got: %v", found)
+				}
+				return
+			}
+
+			if found == nil {
+				t.Fatalf("expected invalid_schema_path diagnostic in report, got: %s", reportBytes)
+			}
+
+			if found["resource"] != "pet" || found["line"] == nil || found["json_pointer"] == nil {
+				t.Errorf("expected invalid_schema_path diagnostic with resource, line, and JSON pointer, got: %v", found)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/cli"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// idProperty is the name of the create response property that identifies a resource, used to suggest aliases for the read path
+// parameter.
+const idProperty = "id"
+
+// This regex matches characters that aren't valid in a provider name, used to derive a provider name from the OpenAPI spec title
+//   - Swagger Petstore - OpenAPI 3.0 = swagger_petstore_openapi_3_0
+var invalidProviderNameRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+type InitCommand struct {
+	UI               cli.Ui
+	oasInputPath     string
+	flagOutputPath   string
+	flagProviderName string
+}
+
+func (cmd *InitCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("init", flag.ExitOnError)
+	fs.StringVar(&cmd.flagOutputPath, "output", "./generator_config.yml", "destination file path for the scaffolded generator config (YAML)")
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "name of the provider, derived from the OpenAPI spec title if not set")
+	return fs
+}
+
+func (cmd *InitCommand) Help() string {
+	return commandHelp("tfplugingen-openapi init [<args>] </path/to/oas_file.yml>", cmd.Flags())
+}
+
+func (cmd *InitCommand) Synopsis() string {
+	return "Scaffolds a generator config from the resources and data sources discovered in an OpenAPI 3.x Specification"
+}
+
+func (cmd *InitCommand) Run(args []string) int {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
+	cmd.oasInputPath = fs.Arg(0)
+	if cmd.oasInputPath == "" {
+		logger.Error("error executing command", "err", "OpenAPI specification file is required as last argument")
+		return 1
+	}
+
+	err = cmd.runInternal(logger)
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	cmd.UI.Output(fmt.Sprintf("Generator config %q scaffolded from OpenAPI spec %q, review it before generating", cmd.flagOutputPath, cmd.oasInputPath))
+
+	return 0
+}
+
+func (cmd *InitCommand) runInternal(logger *slog.Logger) error {
+	// 1. Don't overwrite an existing generator config
+	_, err := os.Stat(cmd.flagOutputPath)
+	if err == nil {
+		return fmt.Errorf("generator config file %q already exists", cmd.flagOutputPath)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error checking generator config file: %w", err)
+	}
+
+	// 2. Read, parse, and build the OpenAPI model
+	model, err := buildOpenAPIModel(logger, cmd.oasInputPath)
+	if err != nil {
+		return err
+	}
+
+	// 3. Discover resources and data sources by naming convention
+	dora := explorer.NewGuesstimatorExplorer(*model)
+	resources, err := dora.FindResourceCandidates()
+	if err != nil {
+		return fmt.Errorf("error finding resource(s): %w", err)
+	}
+
+	dataSources, err := dora.FindDataSourceCandidates()
+	if err != nil {
+		return fmt.Errorf("error finding data source(s): %w", err)
+	}
+
+	if len(resources) == 0 && len(dataSources) == 0 {
+		return errors.New("no resources or data sources found in OpenAPI spec")
+	}
+
+	// 4. Write the generator config
+	providerName := cmd.flagProviderName
+	if providerName == "" {
+		providerName = providerNameFromSpec(model)
+	}
+
+	configYAML := scaffoldConfig(cmd.oasInputPath, providerName, resources, dataSources)
+	err = os.WriteFile(cmd.flagOutputPath, []byte(configYAML), 0644)
+	if err != nil {
+		return fmt.Errorf("error writing generator config: %w", err)
+	}
+
+	return nil
+}
+
+// providerNameFromSpec returns the title of the OpenAPI spec converted to a provider name, or "example" if there is no title.
+func providerNameFromSpec(model *high.Document) string {
+	name := ""
+	if model.Info != nil {
+		name = strings.Trim(invalidProviderNameRegex.ReplaceAllString(strings.ToLower(model.Info.Title), "_"), "_")
+	}
+
+	if name == "" {
+		return "example"
+	}
+
+	return name
+}
+
+// scaffoldConfig returns a commented generator config YAML, with every resource and data source candidate. Ambiguous resource
+// candidates, and data source candidates without a mappable read response, are commented out with the reason they are ambiguous.
+func scaffoldConfig(oasPath string, providerName string, resources []explorer.ResourceCandidate, dataSources []explorer.DataSourceCandidate) string {
+	b := &strings.Builder{}
+
+	b.WriteString(fmt.Sprintf("# Generator config scaffolded by `tfplugingen-openapi init` from the OpenAPI spec %q.\n", oasPath))
+	b.WriteString("#\n")
+	b.WriteString("# Resources and data sources were discovered by naming convention, review every entry before generating. Ambiguous\n")
+	b.WriteString("# resources and data sources are commented out, with the reason they can't be generated as-is.\n")
+	b.WriteString("\n")
+	b.WriteString("provider:\n")
+	b.WriteString(fmt.Sprintf("  name: %s\n", yamlScalar(providerName)))
+
+	if len(resources) > 0 {
+		b.WriteString("\nresources:\n")
+	}
+	for i, resource := range resources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		// Candidate names are derived from paths, which may not be valid Terraform identifiers
+		lines := []string{fmt.Sprintf("%s:", yamlScalar(util.TerraformIdentifier(resource.Name)))}
+		for _, op := range []struct {
+			name     string
+			location *config.OpenApiSpecLocation
+		}{
+			{name: "create", location: resource.Create},
+			{name: "read", location: resource.Read},
+			{name: "update", location: resource.Update},
+			{name: "delete", location: resource.Delete},
+		} {
+			// Ambiguous candidates are commented out, so only the reason they are ambiguous is explained
+			if resource.Ambiguous == "" {
+				lines = append(lines, rankingLines(resource.Ranking[op.name])...)
+			}
+			lines = append(lines, locationLines(op.name, op.location)...)
+		}
+
+		if param, ok := suggestedAlias(resource); ok {
+			lines = append(lines,
+				"  schema:",
+				"    attributes:",
+				"      aliases:",
+				fmt.Sprintf("        # The read path parameter doesn't match the %q property of the create response", idProperty),
+				fmt.Sprintf("        %s: %s", yamlScalar(param), idProperty),
+			)
+		}
+
+		if resource.Ambiguous != "" {
+			b.WriteString(fmt.Sprintf("  # Ambiguous: %s\n", resource.Ambiguous))
+			for j, line := range lines {
+				lines[j] = "# " + line
+			}
+		}
+
+		for _, line := range lines {
+			b.WriteString(fmt.Sprintf("  %s\n", line))
+		}
+	}
+
+	if len(dataSources) > 0 {
+		b.WriteString("\ndata_sources:\n")
+	}
+	for i, dataSource := range dataSources {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		lines := []string{fmt.Sprintf("%s:", yamlScalar(util.TerraformIdentifier(dataSource.Name)))}
+		lines = append(lines, locationLines("read", dataSource.Read)...)
+
+		if ambiguous := unmappableDataSource(dataSource); ambiguous != "" {
+			b.WriteString(fmt.Sprintf("  # Ambiguous: %s\n", ambiguous))
+			for j, line := range lines {
+				lines[j] = "# " + line
+			}
+		}
+
+		for _, line := range lines {
+			b.WriteString(fmt.Sprintf("  %s\n", line))
+		}
+	}
+
+	return b.String()
+}
+
+// rankingLines returns YAML comment lines that explain why the first candidate operation was chosen, followed by every alternative
+// candidate operation, indented under the resource.
+func rankingLines(candidates []explorer.OperationCandidate) []string {
+	lines := []string{}
+	for i, candidate := range candidates {
+		if i == 0 {
+			lines = append(lines, fmt.Sprintf("  # %s", candidate.Reason))
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("  # Alternative: %s %s - %s", candidate.Location.Method, candidate.Location.Path, candidate.Reason))
+	}
+
+	return lines
+}
+
+// locationLines returns the YAML lines of an operation location, indented under the resource or data source, or no lines if
+// there is no location.
+func locationLines(name string, location *config.OpenApiSpecLocation) []string {
+	if location == nil {
+		return nil
+	}
+
+	return []string{
+		fmt.Sprintf("  %s:", name),
+		fmt.Sprintf("    path: %s", yamlScalar(location.Path)),
+		fmt.Sprintf("    method: %s", location.Method),
+	}
+}
+
+// suggestedAlias returns the read path parameter of a resource, if it doesn't match a property of the create response but the
+// create response has an "id" property. The alias merges the path parameter attribute with the "id" attribute.
+func suggestedAlias(resource explorer.ResourceCandidate) (string, bool) {
+	if resource.Read == nil || resource.Resource.CreateOp == nil {
+		return "", false
+	}
+
+	lastSegment := path.Base(resource.Read.Path)
+	if !strings.HasPrefix(lastSegment, "{") || !strings.HasSuffix(lastSegment, "}") {
+		return "", false
+	}
+	param := strings.TrimSuffix(strings.TrimPrefix(lastSegment, "{"), "}")
+	if param == idProperty {
+		return "", false
+	}
+
+	s, err := oas.BuildSchemaFromResponse(resource.Resource.CreateOp, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if err != nil {
+		return "", false
+	}
+
+	if s.HasProperty([]string{param}) || !s.HasProperty([]string{idProperty}) {
+		return "", false
+	}
+
+	return param, true
+}
+
+// unmappableDataSource returns the reason a data source candidate can't be generated as-is, if the response body of the read
+// operation can't be mapped to any attributes. Otherwise, an empty string is returned.
+func unmappableDataSource(dataSource explorer.DataSourceCandidate) string {
+	s, err := oas.BuildSchemaFromResponse(dataSource.DataSource.ReadOp, oas.SchemaOpts{}, oas.GlobalSchemaOpts{
+		OverrideComputability: schema.Computed,
+	})
+	if err != nil {
+		return fmt.Sprintf("read response body can't be mapped: %s", err)
+	}
+
+	// Array response bodies are mapped to a single set attribute, like the data source mapper does
+	if s.Type == util.OAS_type_array {
+		s.Format = util.TF_format_set
+
+		_, schemaErr := s.BuildDataSourceAttribute(dataSource.Name, schema.Computed)
+		if schemaErr != nil {
+			return fmt.Sprintf("read response body can't be mapped: %s", schemaErr)
+		}
+
+		return ""
+	}
+
+	attributes, schemaErr := s.BuildDataSourceAttributes()
+	if schemaErr != nil {
+		return fmt.Sprintf("read response body can't be mapped: %s", schemaErr)
+	}
+
+	if len(attributes) == 0 {
+		return "read response body has no properties to map to attributes"
+	}
+
+	return ""
+}
+
+// yamlScalar returns a string as a YAML scalar, which is quoted if needed.
+func yamlScalar(value string) string {
+	bytes, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%q", value)
+	}
+
+	return strings.TrimSuffix(string(bytes), "\n")
+}
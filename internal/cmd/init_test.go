@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/cmd"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+)
+
+func TestInit(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath    string
+		goldenFilePath string
+	}{
+		"Swagger Petstore - OpenAPI 3.0": {
+			oasSpecPath:    "testdata/petstore3/openapi_spec.json",
+			goldenFilePath: "testdata/petstore3/init_generator_config.yml",
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tempConfigPath := path.Join(t.TempDir(), "generator_config.yml")
+
+			mockUi := cli.NewMockUi()
+			c := cmd.InitCommand{UI: mockUi}
+			args := []string{
+				"--output", tempConfigPath,
+				"--provider-name", "petstore",
+				testCase.oasSpecPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != 0 {
+				t.Fatalf("unexpected error running init cmd: %s", mockUi.ErrorWriter.String())
+			}
+
+			goldenFileBytes, err := os.ReadFile(testCase.goldenFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tempConfigBytes, err := os.ReadFile(tempConfigPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(string(tempConfigBytes), string(goldenFileBytes)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			_, err = config.ParseConfig(tempConfigBytes)
+			if err != nil {
+				t.Errorf("unexpected error parsing scaffolded generator config: %s", err)
+			}
+		})
+	}
+}
+
+func TestInit_ExistingConfig(t *testing.T) {
+	t.Parallel()
+
+	configPath := path.Join(t.TempDir(), "generator_config.yml")
+	err := os.WriteFile(configPath, []byte("provider:\n  name: existing\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockUi := cli.NewMockUi()
+	c := cmd.InitCommand{UI: mockUi}
+	exitCode := c.Run([]string{"--output", configPath, "testdata/petstore3/openapi_spec.json"})
+	if exitCode != 1 {
+		t.Fatalf("expected exit code 1, got %d", exitCode)
+	}
+
+	configBytes, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(configBytes), "name: existing") {
+		t.Errorf("expected existing generator config to not be overwritten, got: %s", configBytes)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
+)
+
+const (
+	reportFormatJSON  = "json"
+	reportFormatSARIF = "sarif"
+)
+
+// jsonReport is the top-level object of a JSON diagnostics report.
+type jsonReport struct {
+	Diagnostics []log.Diagnostic `json:"diagnostics"`
+}
+
+// sarifReport is the top-level object of a SARIF 2.1.0 log, containing the subset of properties needed to report diagnostics.
+//
+// Refer to the [SARIF 2.1.0 specification] for all properties.
+//
+// [SARIF 2.1.0 specification]: https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string `json:"name"`
+	InformationURI string `json:"informationUri"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId,omitempty"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int           `json:"startLine"`
+	StartColumn int           `json:"startColumn,omitempty"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+// validateReportFormat returns an error if format isn't a supported diagnostics report format.
+func validateReportFormat(format string) error {
+	if format != reportFormatJSON && format != reportFormatSARIF {
+		return fmt.Errorf("invalid report format: %q - must be one of %q, %q", format, reportFormatJSON, reportFormatSARIF)
+	}
+
+	return nil
+}
+
+// writeReport writes all diagnostics to path in the given format, either "json" or "sarif". Diagnostics with a line number and no
+// file are located in oasPath.
+func writeReport(path string, format string, oasPath string, diagnostics []log.Diagnostic) error {
+	for i, diagnostic := range diagnostics {
+		if diagnostic.File == "" && diagnostic.Line != 0 {
+			diagnostics[i].File = oasPath
+		}
+	}
+
+	err := validateReportFormat(format)
+	if err != nil {
+		return err
+	}
+
+	var report any = jsonReport{Diagnostics: diagnostics}
+	if format == reportFormatSARIF {
+		report = newSARIFReport(diagnostics)
+	}
+
+	bytes, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return fmt.Errorf("error marshalling diagnostics report: %w", err)
+	}
+
+	err = os.WriteFile(path, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing diagnostics report: %w", err)
+	}
+
+	return nil
+}
+
+// newSARIFReport returns a SARIF log with a single run, where every diagnostic is a result. The resource, data source, or provider
+// and attribute path of a diagnostic are reported as a logical location, like `resource.pet.tags.name`.
+func newSARIFReport(diagnostics []log.Diagnostic) sarifReport {
+	results := make([]sarifResult, 0, len(diagnostics))
+	for _, diagnostic := range diagnostics {
+		result := sarifResult{
+			RuleID:  string(diagnostic.Code),
+			Level:   diagnostic.Severity,
+			Message: sarifMessage{Text: diagnostic.Message},
+		}
+
+		location := sarifLocation{}
+		if diagnostic.File != "" {
+			location.PhysicalLocation = &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: diagnostic.File},
+			}
+			if diagnostic.Line != 0 {
+				location.PhysicalLocation.Region = &sarifRegion{
+					StartLine:   diagnostic.Line,
+					StartColumn: diagnostic.Column,
+				}
+				if diagnostic.Excerpt != "" {
+					location.PhysicalLocation.Region.Snippet = &sarifMessage{Text: diagnostic.Excerpt}
+				}
+			}
+		}
+
+		// SARIF locations don't support JSON pointers, so they are added to the property bag
+		if diagnostic.JSONPointer != "" {
+			result.Properties = map[string]string{"jsonPointer": diagnostic.JSONPointer}
+		}
+
+		if name := logicalLocationName(diagnostic); name != "" {
+			location.LogicalLocations = []sarifLogicalLocation{
+				{
+					FullyQualifiedName: name,
+					Kind:               "member",
+				},
+			}
+		}
+
+		if location.PhysicalLocation != nil || len(location.LogicalLocations) > 0 {
+			result.Locations = []sarifLocation{location}
+		}
+
+		results = append(results, result)
+	}
+
+	return sarifReport{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "tfplugingen-openapi",
+						InformationURI: "https://github.com/hashicorp/terraform-plugin-codegen-openapi",
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+// logicalLocationName returns the Terraform object and attribute path of a diagnostic, joined with ".".
+func logicalLocationName(diagnostic log.Diagnostic) string {
+	parts := make([]string, 0)
+	switch {
+	case diagnostic.Resource != "":
+		parts = append(parts, "resource", diagnostic.Resource)
+	case diagnostic.DataSource != "":
+		parts = append(parts, "data_source", diagnostic.DataSource)
+	case diagnostic.Provider != "":
+		parts = append(parts, "provider", diagnostic.Provider)
+	}
+
+	if diagnostic.AttributePath != "" {
+		parts = append(parts, diagnostic.AttributePath)
+	}
+
+	return strings.Join(parts, ".")
+}
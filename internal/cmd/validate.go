@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
+
+	"github.com/hashicorp/cli"
+)
+
+type ValidateCommand struct {
+	UI             cli.Ui
+	oasInputPath   string
+	flagConfigPath string
+}
+
+func (cmd *ValidateCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfigPath, "config", "./generator_config.yml", "path to generator config file (YAML)")
+	return fs
+}
+
+func (cmd *ValidateCommand) Help() string {
+	return commandHelp("tfplugingen-openapi validate [<args>] </path/to/oas_file.yml>", cmd.Flags())
+}
+
+func (cmd *ValidateCommand) Synopsis() string {
+	return "Validates a generator config against an OpenAPI 3.x Specification, without generating any output"
+}
+
+func (cmd *ValidateCommand) Run(args []string) int {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
+	cmd.oasInputPath = fs.Arg(0)
+	if cmd.oasInputPath == "" {
+		logger.Error("error executing command", "err", "OpenAPI specification file is required as last argument")
+		return 1
+	}
+
+	problems, err := cmd.runInternal(logger)
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	if len(problems) > 0 {
+		cmd.UI.Error(problemSummary("Validation summary", problems))
+		return 1
+	}
+
+	cmd.UI.Output(fmt.Sprintf("Generator config %q is valid for OpenAPI spec %q", cmd.flagConfigPath, cmd.oasInputPath))
+
+	return 0
+}
+
+// runInternal returns every problem found in the generator config, or an error if the generator config or OpenAPI spec couldn't
+// be read.
+func (cmd *ValidateCommand) runInternal(logger *slog.Logger) ([]error, error) {
+	// 1. Read and parse generator config file
+	configBytes, err := os.ReadFile(cmd.flagConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading generator config file: %w", err)
+	}
+	config, err := config.ParseConfig(configBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generator config file: %w", err)
+	}
+
+	// 2. Read, parse, and build the OpenAPI model
+	model, err := buildOpenAPIModel(logger, cmd.oasInputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Validate the generator config against the OpenAPI model
+	return validateConfig(explorer.NewConfigExplorer(*model, *config)), nil
+}
+
+// validateConfig returns every problem found when checking the generator config against the OpenAPI spec:
+//   - The path and method, or operation ID, of every operation must exist.
+//   - Every ignore must match a property or parameter.
+//   - Every override must match an attribute.
+//   - Every alias must match a parameter.
+func validateConfig(dora explorer.Explorer) []error {
+	var problems []error
+
+	// 1. Check the operations of all resources and data sources. Explorer errors are sorted, as resources and data sources are
+	// explored in random order.
+	explorerResources, err := dora.FindResources()
+	if err != nil {
+		problems = append(problems, sortedErrors(unwrapJoined(err))...)
+	}
+
+	explorerDataSources, err := dora.FindDataSources()
+	if err != nil {
+		problems = append(problems, sortedErrors(unwrapJoined(err))...)
+	}
+
+	explorerProvider, err := dora.FindProvider()
+	if err != nil {
+		problems = append(problems, err)
+	}
+
+	// 2. Check the ignores, overrides, and aliases of every resource and data source that was found, and the provider
+	err = mapper.ValidateResources(explorerResources)
+	if err != nil {
+		problems = append(problems, unwrapJoined(err)...)
+	}
+
+	err = mapper.ValidateDataSources(explorerDataSources)
+	if err != nil {
+		problems = append(problems, unwrapJoined(err)...)
+	}
+
+	err = mapper.ValidateProvider(explorerProvider)
+	if err != nil {
+		problems = append(problems, unwrapJoined(err)...)
+	}
+
+	return problems
+}
+
+// sortedErrors returns errs sorted by error message.
+func sortedErrors(errs []error) []error {
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errs
+}
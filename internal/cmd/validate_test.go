@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/cmd"
+)
+
+// invalidPetstoreConfig contains typos in operations, ignores, overrides, and aliases of the petstore3 OpenAPI spec, and an alias
+// of a parameter that isn't mapped.
+const invalidPetstoreConfig = `
+provider:
+  name: petstore
+
+resources:
+  pet:
+    create:
+      operation_id: addPets
+    read:
+      operation_id: getPetById
+  order:
+    create:
+      path: /store/orders
+      method: POST
+    read:
+      path: /store/order/{orderId}
+      method: PATCH
+  pet_with_key:
+    create:
+      path: /pet
+      method: POST
+    read:
+      path: /pet/{petId}
+      method: GET
+    delete:
+      path: /pet/{petId}
+      method: DELETE
+    schema:
+      attributes:
+        aliases:
+          # Header parameters aren't mapped by default
+          api_key: key
+  user:
+    create:
+      path: /user
+      method: POST
+    read:
+      path: /user/{username}
+      method: GET
+    schema:
+      ignores:
+        - usrname
+        - email
+      attributes:
+        overrides:
+          firstName:
+            description: The user's first name
+          fristName:
+            description: The user's first name
+        aliases:
+          userName: id
+
+data_sources:
+  pet:
+    read:
+      path: /pet/{petId}
+      method: GET
+    schema:
+      ignores:
+        - tags.name
+        - tags.nme
+      attributes:
+        overrides:
+          category.nme:
+            description: The category name
+          name.first:
+            description: The first name
+        aliases:
+          petId: id
+`
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath      string
+		config           string
+		configPath       string
+		expectedExitCode int
+		expectedSummary  []string
+	}{
+		"valid - petstore3": {
+			oasSpecPath:      "testdata/petstore3/openapi_spec.json",
+			configPath:       "testdata/petstore3/generator_config.yml",
+			expectedExitCode: 0,
+		},
+		"valid - edgecase": {
+			oasSpecPath:      "testdata/edgecase/openapi_spec.yml",
+			configPath:       "testdata/edgecase/generator_config.yml",
+			expectedExitCode: 0,
+		},
+		"invalid - petstore3": {
+			oasSpecPath:      "testdata/petstore3/openapi_spec.json",
+			config:           invalidPetstoreConfig,
+			expectedExitCode: 1,
+			expectedSummary: []string{
+				"Validation summary: 10 problem(s) found",
+				`  - failed to extract 'order.create': path '/store/orders' not found in OpenAPI spec`,
+				`  - failed to extract 'order.read': method 'PATCH' not found at OpenAPI path '/store/order/{orderId}'`,
+				`  - failed to extract 'pet.create': operation_id 'addPets' not found in OpenAPI spec`,
+				`  - resource 'pet_with_key' alias "api_key" doesn't match any mapped parameter`,
+				`  - resource 'user' ignore "usrname" doesn't match any property or parameter`,
+				`  - resource 'user' override "fristName" doesn't match any attribute`,
+				`  - resource 'user' alias "userName" doesn't match any mapped parameter`,
+				`  - data_source 'pet' ignore "tags.nme" doesn't match any property or parameter`,
+				`  - data_source 'pet' override "category.nme" doesn't match any attribute`,
+				`  - data_source 'pet' override "name.first" doesn't match any attribute`,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			configPath := testCase.configPath
+			if testCase.config != "" {
+				configPath = path.Join(t.TempDir(), "generator_config.yml")
+				err := os.WriteFile(configPath, []byte(testCase.config), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ValidateCommand{UI: mockUi}
+			args := []string{
+				"--config", configPath,
+				testCase.oasSpecPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			var gotSummary []string
+			if summary := strings.TrimSpace(mockUi.ErrorWriter.String()); summary != "" {
+				gotSummary = strings.Split(summary, "\n")
+			}
+
+			if diff := cmp.Diff(gotSummary, testCase.expectedSummary); diff != "" {
+				t.Errorf("unexpected difference in summary: %s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 
 	"gopkg.in/yaml.v3"
 )
@@ -19,11 +24,43 @@ import (
 //   - .category = NO MATCH
 var attributeLocationRegex = regexp.MustCompile(`^[\w]+(?:\.[\w]+)*$`)
 
+// This regex matches OpenAPI response codes, which are either an HTTP status code or a range of HTTP status codes
+//   - 200 = MATCH
+//   - 2XX = MATCH
+//   - default = NO MATCH
+//   - 600 = NO MATCH
+var responseCodeRegex = regexp.MustCompile(`^[1-5](?:\d\d|XX)$`)
+
 // Config represents a YAML generator config.
 type Config struct {
 	Provider    Provider              `yaml:"provider"`
+	MultiType   MultiTypeOptions      `yaml:"multi_type"`
 	Resources   map[string]Resource   `yaml:"resources"`
 	DataSources map[string]DataSource `yaml:"data_sources"`
+	Suppress    []Suppression         `yaml:"suppress"`
+}
+
+// Suppression generator config section. A suppression silences warnings with a diagnostic code, either globally or for a specific
+// resource, data source, or attribute. Errors are never suppressed.
+type Suppression struct {
+	// Code is the diagnostic code of the warnings to suppress, like "multi_type". Refer to the diagnostic package for all codes.
+	Code string `yaml:"code"`
+	// Resource limits the suppression to warnings for a single resource. Can't be used with DataSource.
+	Resource string `yaml:"resource"`
+	// DataSource limits the suppression to warnings for a single data source. Can't be used with Resource.
+	DataSource string `yaml:"data_source"`
+	// AttributePath limits the suppression to warnings for an attribute location (dot-separated for nested attributes) and all of
+	// it's nested attributes.
+	AttributePath string `yaml:"attribute_path"`
+}
+
+// MultiTypeOptions generator config section. This section is used to control how unsupported multi-types, like `type: [integer, object]`
+// or a `oneOf` with a string and an array subschema, are mapped for the provider, all resources, and all data sources.
+type MultiTypeOptions struct {
+	// Strategy determines how an unsupported multi-type is mapped. Either "json", which maps the attribute to a normalized JSON string
+	// attribute, "widest", which maps the attribute to the widest type that can represent all of the types, or "drop", which removes the
+	// attribute from the schema. If not set, an unsupported multi-type will fail the mapping of the entire resource or data source.
+	Strategy string `yaml:"strategy"`
 }
 
 // Provider generator config section.
@@ -51,7 +88,8 @@ type DataSource struct {
 	SchemaOptions SchemaOptions        `yaml:"schema"`
 }
 
-// OpenApiSpecLocation defines a location in an OpenAPI spec for an API operation.
+// OpenApiSpecLocation defines a location in an OpenAPI spec for an API operation. An operation can either be located
+// with a path and method, or with an operation ID.
 type OpenApiSpecLocation struct {
 	// Matches the path key for a path item (refer to [OAS Paths Object]).
 	//
@@ -61,6 +99,33 @@ type OpenApiSpecLocation struct {
 	//
 	// [OAS Path Item Object]: https://spec.openapis.org/oas/v3.1.0#pathItemObject
 	Method string `yaml:"method"`
+	// Matches the operationId of an operation (refer to [OAS Operation Object]). Can't be used with Path and Method.
+	//
+	// [OAS Operation Object]: https://spec.openapis.org/oas/v3.1.0#operation-object
+	OperationId string `yaml:"operation_id"`
+
+	// Envelope is the name of a built-in envelope style that wraps the request and response body schemas of the operation.
+	// Refer to EnvelopePresets for the available envelope styles.
+	Envelope string `yaml:"envelope"`
+	// RequestPath is a location (dot-separated for nested properties) in the request body schema to map instead of the entire request
+	// body schema, which is used to unwrap envelopes like `{"data": {...}}`. Takes precedence over the envelope style.
+	RequestPath string `yaml:"request_path"`
+	// ResponsePath is a location (dot-separated for nested properties) in the response body schema to map instead of the entire
+	// response body schema, which is used to unwrap envelopes like `{"data": {...}}`. Takes precedence over the envelope style.
+	ResponsePath string `yaml:"response_path"`
+	// ResponseCode is the response code (like "200" or "2XX") of the response body to map, instead of the default response code order.
+	ResponseCode string `yaml:"response_code"`
+	// MediaType is the media type of the request and response bodies to map, instead of the default media type order.
+	MediaType string `yaml:"media_type"`
+}
+
+// EnvelopePresets are the built-in envelope styles, with the key being the name of the envelope style and the value being the
+// location of the wrapped schema in both the request and response body schemas.
+var EnvelopePresets = map[string]string{
+	// Wrapped in a data property: `{"data": {...}}`
+	"data": "data",
+	// Wrapped in a JSON:API resource object: `{"data": {"attributes": {...}}}`
+	"jsonapi": "data.attributes",
 }
 
 // SchemaOptions generator config section. This section contains options for modifying the output of the generator.
@@ -68,11 +133,36 @@ type SchemaOptions struct {
 	// Ignores are a slice of strings, representing an attribute location to ignore during mapping (dot-separated for nested attributes).
 	Ignores          []string         `yaml:"ignores"`
 	AttributeOptions AttributeOptions `yaml:"attributes"`
+	ParameterOptions ParameterOptions `yaml:"parameters"`
+	RecursionOptions RecursionOptions `yaml:"recursion"`
+	// MergeConflicts determines which attribute is kept when the same attribute has different types in the request and response
+	// schemas. Either "prefer_request" (default), "prefer_response", or "fail", which will skip mapping the resource or data source.
+	MergeConflicts string `yaml:"merge_conflicts"`
+}
+
+// RecursionOptions generator config section. This section is used to control how recursive schemas, like a tree node that contains
+// a list of child tree nodes, are mapped.
+type RecursionOptions struct {
+	// MaxDepth is the number of times a recursive schema can be nested within itself before the strategy is applied. Defaults to 0.
+	MaxDepth int `yaml:"max_depth"`
+	// Strategy determines how a recursive property that exceeds the max depth is mapped. Either "json" (default), which maps the
+	// property to a JSON-encoded string attribute, or "drop", which removes the property from the schema.
+	Strategy string `yaml:"strategy"`
+}
+
+// ParameterOptions generator config section. This section is used to opt-in to mapping parameters that are not in the path or query.
+type ParameterOptions struct {
+	// Headers will map parameters with a location of "header" to attributes. A leading "X-" is removed from the header name
+	// and hyphens are replaced with underscores, so "X-Org-Id" is mapped to "org_id".
+	Headers bool `yaml:"headers"`
+	// Cookies will map parameters with a location of "cookie" to attributes. Hyphens are replaced with underscores.
+	Cookies bool `yaml:"cookies"`
 }
 
 // AttributeOptions generator config section. This section is used to modify the output of specific attributes.
 type AttributeOptions struct {
 	// Aliases are a map, with the key being a parameter name in an OpenAPI operation and the value being the new name (alias).
+	// Header parameter names are matched case-insensitively.
 	Aliases map[string]string `yaml:"aliases"`
 	// Overrides are a map, with the key being an attribute location (dot-separated for nested attributes) and the value being overrides to apply to the attribute.
 	Overrides map[string]Override `yaml:"overrides"`
@@ -82,6 +172,12 @@ type AttributeOptions struct {
 type Override struct {
 	// Description overrides the description that was mapped/merged from the OpenAPI specification.
 	Description string `yaml:"description"`
+	// JSONString will map the attribute to a string attribute that holds normalized JSON, regardless of the schema type.
+	JSONString bool `yaml:"json_string"`
+	// MultiType overrides the multi-type strategy for the attribute and it's nested attributes. Refer to MultiTypeOptions for the strategies.
+	MultiType string `yaml:"multi_type"`
+	// Computability overrides the inferred computability of the attribute. Either "required", "optional", "computed", or "computed_optional".
+	Computability string `yaml:"computability"`
 }
 
 // ParseConfig takes in a byte array (of YAML), unmarshals into a Config struct, and validates the result
@@ -112,6 +208,27 @@ func (c Config) Validate() error {
 		result = errors.Join(result, fmt.Errorf("\tprovider %w", err))
 	}
 
+	err = c.MultiType.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("\tmulti_type %w", err))
+	}
+
+	// Validate all Suppressions
+	for i, suppression := range c.Suppress {
+		err := suppression.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("\tsuppress[%d] %w", i, err))
+		}
+
+		if _, ok := c.Resources[suppression.Resource]; suppression.Resource != "" && !ok {
+			result = errors.Join(result, fmt.Errorf("\tsuppress[%d] resource %q not found in 'resources'", i, suppression.Resource))
+		}
+
+		if _, ok := c.DataSources[suppression.DataSource]; suppression.DataSource != "" && !ok {
+			result = errors.Join(result, fmt.Errorf("\tsuppress[%d] data_source %q not found in 'data_sources'", i, suppression.DataSource))
+		}
+	}
+
 	// Validate all Resources
 	for name, resource := range c.Resources {
 		err := resource.Validate()
@@ -211,17 +328,75 @@ func (o *OpenApiSpecLocation) Validate() error {
 		return nil
 	}
 
-	if o.Path == "" {
-		result = errors.Join(result, errors.New("'path' property is required"))
+	if o.OperationId != "" {
+		if o.Path != "" || o.Method != "" {
+			result = errors.Join(result, errors.New("'operation_id' property can't be used with 'path' or 'method' properties"))
+		}
+	} else {
+		if o.Path == "" {
+			result = errors.Join(result, errors.New("'path' property is required"))
+		}
+
+		if o.Method == "" {
+			result = errors.Join(result, errors.New("'method' property is required"))
+		}
 	}
 
-	if o.Method == "" {
-		result = errors.Join(result, errors.New("'method' property is required"))
+	if _, ok := EnvelopePresets[o.Envelope]; o.Envelope != "" && !ok {
+		result = errors.Join(result, fmt.Errorf("invalid envelope: %q - must be one of %s", o.Envelope, envelopePresetNames()))
+	}
+
+	if o.RequestPath != "" && !attributeLocationRegex.MatchString(o.RequestPath) {
+		result = errors.Join(result, fmt.Errorf("invalid request_path: %q - must be dot-separated string", o.RequestPath))
+	}
+
+	if o.ResponsePath != "" && !attributeLocationRegex.MatchString(o.ResponsePath) {
+		result = errors.Join(result, fmt.Errorf("invalid response_path: %q - must be dot-separated string", o.ResponsePath))
+	}
+
+	if o.ResponseCode != "" && !responseCodeRegex.MatchString(o.ResponseCode) {
+		result = errors.Join(result, fmt.Errorf("invalid response_code: %q - must be an HTTP status code or range, like 200 or 2XX", o.ResponseCode))
 	}
 
 	return result
 }
 
+// RequestSchemaPath returns the location of the schema to map in the request body, either from the request_path or envelope style.
+func (o *OpenApiSpecLocation) RequestSchemaPath() string {
+	if o == nil {
+		return ""
+	}
+
+	if o.RequestPath != "" {
+		return o.RequestPath
+	}
+
+	return EnvelopePresets[o.Envelope]
+}
+
+// ResponseSchemaPath returns the location of the schema to map in the response body, either from the response_path or envelope style.
+func (o *OpenApiSpecLocation) ResponseSchemaPath() string {
+	if o == nil {
+		return ""
+	}
+
+	if o.ResponsePath != "" {
+		return o.ResponsePath
+	}
+
+	return EnvelopePresets[o.Envelope]
+}
+
+func envelopePresetNames() string {
+	names := make([]string, 0, len(EnvelopePresets))
+	for name := range EnvelopePresets {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
+
 func (s *SchemaOptions) Validate() error {
 	var result error
 
@@ -236,17 +411,141 @@ func (s *SchemaOptions) Validate() error {
 		}
 	}
 
+	err = s.RecursionOptions.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("invalid recursion: %w", err))
+	}
+
+	switch s.MergeConflicts {
+	case "", "prefer_request", "prefer_response", "fail":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid merge_conflicts: %q - must be one of \"prefer_request\", \"prefer_response\", \"fail\"", s.MergeConflicts))
+	}
+
+	return result
+}
+
+func (r *RecursionOptions) Validate() error {
+	var result error
+
+	if r.MaxDepth < 0 {
+		result = errors.Join(result, fmt.Errorf("invalid max_depth: %d - must be zero or greater", r.MaxDepth))
+	}
+
+	switch r.Strategy {
+	case "", "json", "drop":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid strategy: %q - must be one of \"json\", \"drop\"", r.Strategy))
+	}
+
 	return result
 }
 
 func (s *AttributeOptions) Validate() error {
 	var result error
 
-	for path := range s.Overrides {
+	for path, override := range s.Overrides {
 		if !attributeLocationRegex.MatchString(path) {
 			result = errors.Join(result, fmt.Errorf("invalid key for override: %q - must be dot-separated string", path))
 		}
+
+		if override.MultiType != "" && !isValidMultiTypeStrategy(override.MultiType) {
+			result = errors.Join(result, fmt.Errorf("invalid multi_type for override %q: %q - must be one of \"json\", \"widest\", \"drop\"", path, override.MultiType))
+		}
+
+		if override.Computability != "" && !isValidComputability(override.Computability) {
+			result = errors.Join(result, fmt.Errorf("invalid computability for override %q: %q - must be one of \"required\", \"optional\", \"computed\", \"computed_optional\"", path, override.Computability))
+		}
+	}
+
+	return result
+}
+
+func (s Suppression) Validate() error {
+	var result error
+
+	if s.Code == "" {
+		result = errors.Join(result, errors.New("must have a 'code' property"))
+	} else if !diagnostic.Code(s.Code).IsValid() {
+		result = errors.Join(result, fmt.Errorf("invalid code: %q - must be one of %s", s.Code, diagnosticCodeNames()))
+	}
+
+	if s.Resource != "" && s.DataSource != "" {
+		result = errors.Join(result, errors.New("'resource' property can't be used with 'data_source' property"))
+	}
+
+	if s.AttributePath != "" && slices.Contains(strings.Split(s.AttributePath, "."), "") {
+		result = errors.Join(result, fmt.Errorf("invalid attribute_path: %q - must be dot-separated string", s.AttributePath))
 	}
 
 	return result
 }
+
+// Suppresses returns true if a warning with the diagnostic code, for the resource or data source and attribute location, is
+// silenced by this suppression.
+func (s Suppression) Suppresses(code string, resource string, dataSource string, attributePath string) bool {
+	if s.Code != code {
+		return false
+	}
+
+	if s.Resource != "" && s.Resource != resource {
+		return false
+	}
+
+	if s.DataSource != "" && s.DataSource != dataSource {
+		return false
+	}
+
+	if s.AttributePath != "" && attributePath != s.AttributePath && !strings.HasPrefix(attributePath, s.AttributePath+".") {
+		return false
+	}
+
+	return true
+}
+
+// IsSuppressed returns true if a warning with the diagnostic code, for the resource or data source and attribute location, is
+// silenced by any suppression in the config.
+func (c Config) IsSuppressed(code string, resource string, dataSource string, attributePath string) bool {
+	for _, suppression := range c.Suppress {
+		if suppression.Suppresses(code, resource, dataSource, attributePath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func diagnosticCodeNames() string {
+	names := make([]string, 0, len(diagnostic.Codes()))
+	for _, code := range diagnostic.Codes() {
+		names = append(names, fmt.Sprintf("%q", code))
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func (m *MultiTypeOptions) Validate() error {
+	if m.Strategy != "" && !isValidMultiTypeStrategy(m.Strategy) {
+		return fmt.Errorf("invalid strategy: %q - must be one of \"json\", \"widest\", \"drop\"", m.Strategy)
+	}
+
+	return nil
+}
+
+func isValidComputability(computability string) bool {
+	switch computability {
+	case "required", "optional", "computed", "computed_optional":
+		return true
+	default:
+		return false
+	}
+}
+
+func isValidMultiTypeStrategy(strategy string) bool {
+	switch strategy {
+	case "json", "widest", "drop":
+		return true
+	default:
+		return false
+	}
+}
@@ -69,7 +69,9 @@ resources:
           "hey.there":
             description: Here is a test description for the 'there' property in 'hey'
           "hey.there.nested.thing":
-            description: Deeply nested property 'thing'`,
+            description: Deeply nested property 'thing'
+          "hey.json":
+            json_string: true`,
 		},
 		"valid resource with ignores": {
 			input: `
@@ -87,6 +89,159 @@ resources:
     schema:
       ignores:
         - valid.ignore.combo`,
+		},
+		"valid resource with parameter options": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      parameters:
+        headers: true
+        cookies: true
+      attributes:
+        aliases:
+          X-Org-Id: organization_id`,
+		},
+		"valid resource with recursion options": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      recursion:
+        max_depth: 2
+        strategy: drop`,
+		},
+		"valid multi-type options": {
+			input: `
+provider:
+  name: example
+
+multi_type:
+  strategy: widest
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          nested.union:
+            multi_type: drop
+          nested.computed:
+            computability: computed_optional`,
+		},
+		"valid suppress": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+data_sources:
+  things:
+    read:
+      path: /example/path/to/things
+      method: GET
+
+suppress:
+  - code: multi_type
+  - code: invalid_identifier
+    resource: thing
+  - code: recursive_schema
+    data_source: things
+    attribute_path: children.children`,
+		},
+		"valid merge conflicts": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      merge_conflicts: prefer_response
+
+data_sources:
+  thing:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      merge_conflicts: fail`,
+		},
+		"valid resource with envelopes": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+      envelope: jsonapi
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+      response_path: result.thing
+    update:
+      path: /example/path/to/thing/{id}
+      method: PATCH
+      envelope: data
+      request_path: thing
+      media_type: application/vnd.thing+json
+      response_code: 2XX`,
+		},
+		"valid resource with operation ids": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      operation_id: createThing
+    read:
+      operation_id: getThing
+    delete:
+      path: /example/path/to/thing/{id}
+      method: DELETE`,
 		},
 		"valid single data source": {
 			input: `
@@ -268,6 +423,88 @@ resources:
       method: POST`,
 			expectedErrRegex: `invalid create: 'path' property is required`,
 		},
+		"resource - invalid create - unknown envelope": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+      envelope: soap
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `invalid create: invalid envelope: "soap" - must be one of "data", "jsonapi"`,
+		},
+		"resource - invalid read - invalid response_path": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+      response_path: .data.`,
+			expectedErrRegex: `invalid read: invalid response_path: ".data." - must be dot-separated string`,
+		},
+		"resource - invalid update - invalid request_path": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    update:
+      path: /example/path/to/thing/{id}
+      method: PUT
+      request_path: data..thing`,
+			expectedErrRegex: `invalid update: invalid request_path: "data..thing" - must be dot-separated string`,
+		},
+		"resource - invalid read - invalid response_code": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+      response_code: default`,
+			expectedErrRegex: `invalid read: invalid response_code: "default" - must be an HTTP status code or range, like 200 or 2XX`,
+		},
+		"resource - invalid create - operation_id with path and method": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+      operation_id: createThing
+    read:
+      operation_id: getThing`,
+			expectedErrRegex: `invalid create: 'operation_id' property can't be used with 'path' or 'method' properties`,
+		},
 		"resource - invalid create - method required": {
 			input: `
 provider:
@@ -383,6 +620,211 @@ resources:
         - .invalid.ignore.`,
 			expectedErrRegex: `invalid item for ignores: \".invalid.ignore.\"`,
 		},
+		"resource - invalid recursion max_depth": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      recursion:
+        max_depth: -1`,
+			expectedErrRegex: `invalid recursion: invalid max_depth: -1 - must be zero or greater`,
+		},
+		"resource - invalid recursion strategy": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      recursion:
+        strategy: flatten`,
+			expectedErrRegex: `invalid recursion: invalid strategy: "flatten" - must be one of "json", "drop"`,
+		},
+		"invalid multi-type strategy": {
+			input: `
+provider:
+  name: example
+
+multi_type:
+  strategy: union
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `multi_type invalid strategy: "union" - must be one of "json", "widest", "drop"`,
+		},
+		"resource - invalid override multi-type strategy": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          nested.union:
+            multi_type: union`,
+			expectedErrRegex: `invalid multi_type for override "nested.union": "union" - must be one of "json", "widest", "drop"`,
+		},
+		"resource - invalid merge conflicts": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      merge_conflicts: prefer_both`,
+			expectedErrRegex: `invalid merge_conflicts: "prefer_both" - must be one of "prefer_request", "prefer_response", "fail"`,
+		},
+		"resource - invalid override computability": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          nested.computed:
+            computability: sometimes`,
+			expectedErrRegex: `invalid computability for override "nested.computed": "sometimes" - must be one of "required", "optional", "computed", "computed_optional"`,
+		},
+		"suppress - code required": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+suppress:
+  - resource: thing_one`,
+			expectedErrRegex: `suppress\[0\] must have a 'code' property`,
+		},
+		"suppress - invalid code": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+suppress:
+  - code: everything`,
+			expectedErrRegex: `suppress\[0\] invalid code: "everything" - must be one of "unsupported_all_of", "multi_type"`,
+		},
+		"suppress - resource with data source": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+suppress:
+  - code: multi_type
+    resource: thing_one
+    data_source: thing_one`,
+			expectedErrRegex: `suppress\[0\] 'resource' property can't be used with 'data_source' property`,
+		},
+		"suppress - resource not found": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+suppress:
+  - code: multi_type
+    resource: thing_two`,
+			expectedErrRegex: `suppress\[0\] resource "thing_two" not found in 'resources'`,
+		},
+		"suppress - invalid attribute path": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+suppress:
+  - code: multi_type
+    attribute_path: nested.`,
+			expectedErrRegex: `suppress\[0\] invalid attribute_path: "nested." - must be dot-separated string`,
+		},
 		"data source - read required": {
 			input: `
 provider:
@@ -464,3 +906,74 @@ data_sources:
 		})
 	}
 }
+
+func TestConfig_IsSuppressed(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{
+		Suppress: []config.Suppression{
+			{Code: "multi_type"},
+			{Code: "invalid_identifier", Resource: "thing"},
+			{Code: "recursive_schema", DataSource: "things", AttributePath: "children"},
+		},
+	}
+
+	testCases := map[string]struct {
+		code          string
+		resource      string
+		dataSource    string
+		attributePath string
+		expected      bool
+	}{
+		"global": {
+			code:          "multi_type",
+			dataSource:    "things",
+			attributePath: "union",
+			expected:      true,
+		},
+		"resource": {
+			code:     "invalid_identifier",
+			resource: "thing",
+			expected: true,
+		},
+		"resource - different resource": {
+			code:     "invalid_identifier",
+			resource: "thing_two",
+			expected: false,
+		},
+		"attribute path": {
+			code:          "recursive_schema",
+			dataSource:    "things",
+			attributePath: "children",
+			expected:      true,
+		},
+		"attribute path - nested": {
+			code:          "recursive_schema",
+			dataSource:    "things",
+			attributePath: "children.children",
+			expected:      true,
+		},
+		"attribute path - different attribute": {
+			code:          "recursive_schema",
+			dataSource:    "things",
+			attributePath: "children_count",
+			expected:      false,
+		},
+		"different code": {
+			code:     "merge_conflict",
+			resource: "thing",
+			expected: false,
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := cfg.IsSuppressed(testCase.code, testCase.resource, testCase.dataSource, testCase.attributePath)
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
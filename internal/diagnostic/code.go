@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic
+
+import (
+	"errors"
+	"slices"
+)
+
+// Code identifies a class of diagnostic, like an unsupported multi-type. Codes are stable between releases, so they can be used
+// to suppress known issues in the generator config, or to filter reports.
+type Code string
+
+const (
+	// CodeUnsupportedAllOf is used when the subschemas of an allOf can't be merged, like contradictory types or enum values.
+	CodeUnsupportedAllOf Code = "unsupported_all_of"
+
+	// CodeMultiType is used when a schema has multiple types that aren't supported, like `type: [integer, object]` or a oneOf/anyOf.
+	CodeMultiType Code = "multi_type"
+
+	// CodeInvalidIdentifier is used when a property name can't be converted to a valid Terraform identifier.
+	CodeInvalidIdentifier Code = "invalid_identifier"
+
+	// CodeInvalidSchemaType is used when a schema type isn't supported in the context it's used in.
+	CodeInvalidSchemaType Code = "invalid_schema_type"
+
+	// CodeMissingSchemaType is used when a schema has no type and no supported allOf, oneOf, or anyOf to infer a type from.
+	CodeMissingSchemaType Code = "missing_schema_type"
+
+	// CodeInvalidArrayItems is used when an array schema doesn't have a valid items schema.
+	CodeInvalidArrayItems Code = "invalid_array_items"
+
+	// CodeInvalidMap is used when a map schema doesn't have a valid additionalProperties schema.
+	CodeInvalidMap Code = "invalid_map"
+
+	// CodeRecursiveSchema is used when a recursive schema exceeds the max depth.
+	CodeRecursiveSchema Code = "recursive_schema"
+
+	// CodeInvalidVariant is used when a oneOf/anyOf subschema can't be mapped as a variant.
+	CodeInvalidVariant Code = "invalid_variant"
+
+	// CodeInvalidSchemaPath is used when a request_path, response_path, or envelope doesn't exist in a request or response body schema.
+	CodeInvalidSchemaPath Code = "invalid_schema_path"
+
+	// CodeInvalidSchemaReference is used when a schema reference can't be resolved or built.
+	CodeInvalidSchemaReference Code = "invalid_schema_reference"
+
+	// CodeSchemaNotFound is used when no request or response body schema is found, like for a configured response code or media type.
+	CodeSchemaNotFound Code = "schema_not_found"
+
+	// CodeMergeConflict is used when the same attribute has different types in the schemas being merged.
+	CodeMergeConflict Code = "merge_conflict"
+
+	// CodeMissingOperation is used when a resource or data source doesn't have an operation required for mapping.
+	CodeMissingOperation Code = "missing_operation"
+
+	// CodeUnusedIgnore is used when an ignore in the generator config doesn't match any property or parameter.
+	CodeUnusedIgnore Code = "unused_ignore"
+
+	// CodeUnusedOverride is used when an override in the generator config doesn't match any attribute.
+	CodeUnusedOverride Code = "unused_override"
+
+	// CodeUnusedAlias is used when an alias in the generator config doesn't match any parameter.
+	CodeUnusedAlias Code = "unused_alias"
+
+	// CodeCircularReference is used when a circular reference is found while building the OpenAPI model.
+	CodeCircularReference Code = "circular_reference"
+
+	// CodeInvalidProviderCodeSpec is used when the generated provider code spec fails validation.
+	CodeInvalidProviderCodeSpec Code = "invalid_provider_code_spec"
+)
+
+// Codes returns all diagnostic codes.
+func Codes() []Code {
+	return []Code{
+		CodeUnsupportedAllOf,
+		CodeMultiType,
+		CodeInvalidIdentifier,
+		CodeInvalidSchemaType,
+		CodeMissingSchemaType,
+		CodeInvalidArrayItems,
+		CodeInvalidMap,
+		CodeRecursiveSchema,
+		CodeInvalidVariant,
+		CodeInvalidSchemaPath,
+		CodeInvalidSchemaReference,
+		CodeSchemaNotFound,
+		CodeMergeConflict,
+		CodeMissingOperation,
+		CodeUnusedIgnore,
+		CodeUnusedOverride,
+		CodeUnusedAlias,
+		CodeCircularReference,
+		CodeInvalidProviderCodeSpec,
+	}
+}
+
+// IsValid returns true if the code is one of the diagnostic codes returned by Codes.
+func (c Code) IsValid() bool {
+	return slices.Contains(Codes(), c)
+}
+
+// codedError wraps an error with a diagnostic code, without changing the error message.
+type codedError struct {
+	code Code
+	err  error
+}
+
+// Error returns the message of the wrapped error
+func (e *codedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *codedError) Unwrap() error {
+	return e.err
+}
+
+// WithCode wraps err with a diagnostic code, which can be retrieved with CodeOf. The error message is unchanged.
+func WithCode(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &codedError{
+		code: code,
+		err:  err,
+	}
+}
+
+// CodeOf returns the first diagnostic code found in the error chain of err, or an empty string if there is none.
+func CodeOf(err error) Code {
+	var codedErr *codedError
+	if errors.As(err, &codedErr) {
+		return codedErr.code
+	}
+
+	return ""
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostic_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+)
+
+func TestCodeOf(t *testing.T) {
+	t.Parallel()
+
+	baseErr := errors.New("unsupported")
+
+	testCases := map[string]struct {
+		err          error
+		expectedCode diagnostic.Code
+	}{
+		"no code": {
+			err:          baseErr,
+			expectedCode: "",
+		},
+		"code": {
+			err:          diagnostic.WithCode(diagnostic.CodeMultiType, baseErr),
+			expectedCode: diagnostic.CodeMultiType,
+		},
+		"wrapped code": {
+			err:          fmt.Errorf("error mapping: %w", diagnostic.WithCode(diagnostic.CodeMultiType, baseErr)),
+			expectedCode: diagnostic.CodeMultiType,
+		},
+		"outermost code": {
+			err:          diagnostic.WithCode(diagnostic.CodeInvalidVariant, fmt.Errorf("variant: %w", diagnostic.WithCode(diagnostic.CodeMultiType, baseErr))),
+			expectedCode: diagnostic.CodeInvalidVariant,
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diagnostic.CodeOf(testCase.err)
+			if got != testCase.expectedCode {
+				t.Errorf("expected code %q, got %q", testCase.expectedCode, got)
+			}
+
+			if !errors.Is(testCase.err, baseErr) {
+				t.Errorf("expected error to wrap base error")
+			}
+		})
+	}
+}
+
+func TestWithCode_Message(t *testing.T) {
+	t.Parallel()
+
+	err := diagnostic.WithCode(diagnostic.CodeMultiType, errors.New("unsupported"))
+	if err.Error() != "unsupported" {
+		t.Errorf("expected error message to be unchanged, got %q", err.Error())
+	}
+
+	if diagnostic.WithCode(diagnostic.CodeMultiType, nil) != nil {
+		t.Errorf("expected nil error to stay nil")
+	}
+}
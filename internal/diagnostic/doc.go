@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package diagnostic contains the stable codes that identify each class of warning and error reported by the generator
+package diagnostic
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
@@ -16,6 +17,7 @@ import (
 	lowmodel "github.com/pb33f/libopenapi/datamodel/low"
 	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
 	low "github.com/pb33f/libopenapi/datamodel/low/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
 )
 
 var _ Explorer = configExplorer{}
@@ -38,7 +40,8 @@ func NewConfigExplorer(spec high.Document, cfg config.Config) Explorer {
 
 func (e configExplorer) FindProvider() (Provider, error) {
 	foundProvider := Provider{
-		Name: e.config.Provider.Name,
+		Name:              e.config.Provider.Name,
+		MultiTypeStrategy: e.config.MultiType.Strategy,
 	}
 
 	if e.config.Provider.SchemaRef == "" {
@@ -59,25 +62,30 @@ func (e configExplorer) FindResources() (map[string]Resource, error) {
 	resources := map[string]Resource{}
 	var errResult error
 
+	operationIds := indexOperationIds(e.spec.Paths)
 	for name, resourceConfig := range e.config.Resources {
-		createOp, err := extractOp(e.spec.Paths, resourceConfig.Create)
+		// All operations are extracted before skipping the resource, so every invalid location is reported
+		var opErr error
+		var createOp, readOp, updateOp, deleteOp *high.Operation
+		var err error
+		resourceConfig.Create, createOp, err = operationIds.extractOp(e.spec.Paths, resourceConfig.Create)
 		if err != nil {
-			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.create': %w", name, err))
-			continue
+			opErr = errors.Join(opErr, fmt.Errorf("failed to extract '%s.create': %w", name, err))
 		}
-		readOp, err := extractOp(e.spec.Paths, resourceConfig.Read)
+		resourceConfig.Read, readOp, err = operationIds.extractOp(e.spec.Paths, resourceConfig.Read)
 		if err != nil {
-			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.read': %w", name, err))
-			continue
+			opErr = errors.Join(opErr, fmt.Errorf("failed to extract '%s.read': %w", name, err))
 		}
-		updateOp, err := extractOp(e.spec.Paths, resourceConfig.Update)
+		resourceConfig.Update, updateOp, err = operationIds.extractOp(e.spec.Paths, resourceConfig.Update)
 		if err != nil {
-			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.update': %w", name, err))
-			continue
+			opErr = errors.Join(opErr, fmt.Errorf("failed to extract '%s.update': %w", name, err))
 		}
-		deleteOp, err := extractOp(e.spec.Paths, resourceConfig.Delete)
+		resourceConfig.Delete, deleteOp, err = operationIds.extractOp(e.spec.Paths, resourceConfig.Delete)
 		if err != nil {
-			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.delete': %w", name, err))
+			opErr = errors.Join(opErr, fmt.Errorf("failed to extract '%s.delete': %w", name, err))
+		}
+		if opErr != nil {
+			errResult = errors.Join(errResult, opErr)
 			continue
 		}
 
@@ -86,14 +94,35 @@ func (e configExplorer) FindResources() (map[string]Resource, error) {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
 			continue
 		}
+		createCommonParameters, err := extractOpCommonParameters(e.spec.Paths, resourceConfig.Create)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.create' common parameters: %w", name, err))
+			continue
+		}
+		updateCommonParameters, err := extractOpCommonParameters(e.spec.Paths, resourceConfig.Update)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.update' common parameters: %w", name, err))
+			continue
+		}
+		deleteCommonParameters, err := extractOpCommonParameters(e.spec.Paths, resourceConfig.Delete)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.delete' common parameters: %w", name, err))
+			continue
+		}
 
 		resources[name] = Resource{
-			CreateOp:         createOp,
-			ReadOp:           readOp,
-			UpdateOp:         updateOp,
-			DeleteOp:         deleteOp,
-			CommonParameters: commonParameters,
-			SchemaOptions:    extractSchemaOptions(resourceConfig.SchemaOptions),
+			CreateOp:               createOp,
+			ReadOp:                 readOp,
+			UpdateOp:               updateOp,
+			DeleteOp:               deleteOp,
+			CommonParameters:       commonParameters,
+			CreateCommonParameters: createCommonParameters,
+			UpdateCommonParameters: updateCommonParameters,
+			DeleteCommonParameters: deleteCommonParameters,
+			CreateOpOptions:        extractOperationOptions(resourceConfig.Create),
+			ReadOpOptions:          extractOperationOptions(resourceConfig.Read),
+			UpdateOpOptions:        extractOperationOptions(resourceConfig.Update),
+			SchemaOptions:          extractSchemaOptions(resourceConfig.SchemaOptions, e.config.MultiType),
 		}
 	}
 
@@ -104,8 +133,11 @@ func (e configExplorer) FindDataSources() (map[string]DataSource, error) {
 	dataSources := map[string]DataSource{}
 	var errResult error
 
+	operationIds := indexOperationIds(e.spec.Paths)
 	for name, dataSourceConfig := range e.config.DataSources {
-		readOp, err := extractOp(e.spec.Paths, dataSourceConfig.Read)
+		var readOp *high.Operation
+		var err error
+		dataSourceConfig.Read, readOp, err = operationIds.extractOp(e.spec.Paths, dataSourceConfig.Read)
 		if err != nil {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.read': %w", name, err))
 			continue
@@ -120,12 +152,84 @@ func (e configExplorer) FindDataSources() (map[string]DataSource, error) {
 		dataSources[name] = DataSource{
 			ReadOp:           readOp,
 			CommonParameters: commonParameters,
-			SchemaOptions:    extractSchemaOptions(dataSourceConfig.SchemaOptions),
+			ReadOpOptions:    extractOperationOptions(dataSourceConfig.Read),
+			SchemaOptions:    extractSchemaOptions(dataSourceConfig.SchemaOptions, e.config.MultiType),
 		}
 	}
 	return dataSources, errResult
 }
 
+// operationIdIndex contains the locations of all operations in an OpenAPI spec with an operationId, indexed by operationId.
+// The OpenAPI spec requires operationIds to be unique, but multiple locations are stored to detect invalid OpenAPI specs.
+type operationIdIndex map[string][]config.OpenApiSpecLocation
+
+func indexOperationIds(paths *high.Paths) operationIdIndex {
+	index := operationIdIndex{}
+	if paths == nil || paths.PathItems == nil {
+		return index
+	}
+
+	for pathPair := range orderedmap.Iterate(context.TODO(), paths.PathItems) {
+		for opPair := range orderedmap.Iterate(context.TODO(), pathPair.Value().GetOperations()) {
+			operationId := opPair.Value().OperationId
+			if operationId == "" {
+				continue
+			}
+
+			index[operationId] = append(index[operationId], config.OpenApiSpecLocation{
+				Path:   pathPair.Key(),
+				Method: strings.ToUpper(opPair.Key()),
+			})
+		}
+	}
+
+	return index
+}
+
+// resolveLocation returns a copy of the OpenAPI spec location with the path and method of the operation populated, if the location
+// uses an operationId. An error is returned if no operations or multiple operations are found with the operationId.
+func (index operationIdIndex) resolveLocation(oasLocation *config.OpenApiSpecLocation) (*config.OpenApiSpecLocation, error) {
+	if oasLocation == nil || oasLocation.OperationId == "" {
+		return oasLocation, nil
+	}
+
+	locations := index[oasLocation.OperationId]
+	switch len(locations) {
+	case 0:
+		return nil, fmt.Errorf("operation_id '%s' not found in OpenAPI spec", oasLocation.OperationId)
+	case 1:
+		resolvedLocation := *oasLocation
+		resolvedLocation.Path = locations[0].Path
+		resolvedLocation.Method = locations[0].Method
+
+		return &resolvedLocation, nil
+	default:
+		foundLocations := make([]string, 0, len(locations))
+		for _, location := range locations {
+			foundLocations = append(foundLocations, fmt.Sprintf("%s %s", location.Method, location.Path))
+		}
+		sort.Strings(foundLocations)
+
+		return nil, fmt.Errorf("operation_id '%s' is ambiguous, found in OpenAPI spec at: %s", oasLocation.OperationId, strings.Join(foundLocations, ", "))
+	}
+}
+
+// extractOp resolves the location of an operation if it uses an operationId, then extracts the operation at the location. The
+// resolved location is returned, so it can be used to find the common parameters of the operation.
+func (index operationIdIndex) extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*config.OpenApiSpecLocation, *high.Operation, error) {
+	resolvedLocation, err := index.resolveLocation(oasLocation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	op, err := extractOp(paths, resolvedLocation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resolvedLocation, op, nil
+}
+
 func extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*high.Operation, error) {
 	// No need to search OAS if not defined
 	if oasLocation == nil {
@@ -138,26 +242,31 @@ func extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*hig
 
 	pathItem, _ := paths.PathItems.Get(oasLocation.Path)
 
+	var op *high.Operation
 	switch strings.ToLower(oasLocation.Method) {
 	case low.PostLabel:
-		return pathItem.Post, nil
+		op = pathItem.Post
 	case low.GetLabel:
-		return pathItem.Get, nil
+		op = pathItem.Get
 	case low.PutLabel:
-		return pathItem.Put, nil
+		op = pathItem.Put
 	case low.DeleteLabel:
-		return pathItem.Delete, nil
+		op = pathItem.Delete
 	case low.PatchLabel:
-		return pathItem.Patch, nil
+		op = pathItem.Patch
 	case low.OptionsLabel:
-		return pathItem.Options, nil
+		op = pathItem.Options
 	case low.HeadLabel:
-		return pathItem.Head, nil
+		op = pathItem.Head
 	case low.TraceLabel:
-		return pathItem.Trace, nil
-	default:
+		op = pathItem.Trace
+	}
+
+	if op == nil {
 		return nil, fmt.Errorf("method '%s' not found at OpenAPI path '%s'", oasLocation.Method, oasLocation.Path)
 	}
+
+	return op, nil
 }
 
 func extractCommonParameters(paths *high.Paths, path string) ([]*high.Parameter, error) {
@@ -171,6 +280,15 @@ func extractCommonParameters(paths *high.Paths, path string) ([]*high.Parameter,
 	return pathItem.Parameters, nil
 }
 
+func extractOpCommonParameters(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) ([]*high.Parameter, error) {
+	// No need to search OAS if not defined
+	if oasLocation == nil {
+		return nil, nil
+	}
+
+	return extractCommonParameters(paths, oasLocation.Path)
+}
+
 func extractSchemaProxy(document high.Document, componentRef string) (*highbase.SchemaProxy, error) {
 	// find the reference using the root document.Index
 	indexRef := document.Index.FindComponentInRoot(componentRef)
@@ -198,20 +316,56 @@ func extractSchemaProxy(document high.Document, componentRef string) (*highbase.
 	return highbase.CreateSchemaProxy(highSchema), nil
 }
 
-func extractSchemaOptions(cfgSchemaOpts config.SchemaOptions) SchemaOptions {
+func extractOperationOptions(oasLocation *config.OpenApiSpecLocation) OperationOptions {
+	if oasLocation == nil {
+		return OperationOptions{}
+	}
+
+	return OperationOptions{
+		RequestPath:  splitSchemaPath(oasLocation.RequestSchemaPath()),
+		ResponsePath: splitSchemaPath(oasLocation.ResponseSchemaPath()),
+		ResponseCode: oasLocation.ResponseCode,
+		MediaType:    oasLocation.MediaType,
+	}
+}
+
+func splitSchemaPath(schemaPath string) []string {
+	if schemaPath == "" {
+		return nil
+	}
+
+	return strings.Split(schemaPath, ".")
+}
+
+func extractSchemaOptions(cfgSchemaOpts config.SchemaOptions, cfgMultiTypeOpts config.MultiTypeOptions) SchemaOptions {
 	return SchemaOptions{
 		Ignores: cfgSchemaOpts.Ignores,
 		AttributeOptions: AttributeOptions{
 			Aliases:   cfgSchemaOpts.AttributeOptions.Aliases,
 			Overrides: extractOverrides(cfgSchemaOpts.AttributeOptions.Overrides),
 		},
+		ParameterOptions: ParameterOptions{
+			Headers: cfgSchemaOpts.ParameterOptions.Headers,
+			Cookies: cfgSchemaOpts.ParameterOptions.Cookies,
+		},
+		RecursionOptions: RecursionOptions{
+			MaxDepth: cfgSchemaOpts.RecursionOptions.MaxDepth,
+			Strategy: cfgSchemaOpts.RecursionOptions.Strategy,
+		},
+		MultiTypeStrategy: cfgMultiTypeOpts.Strategy,
+		MergeConflicts:    cfgSchemaOpts.MergeConflicts,
 	}
 }
 
 func extractOverrides(cfgOverrides map[string]config.Override) map[string]Override {
 	overrides := make(map[string]Override, len(cfgOverrides))
 	for key, cfgOverride := range cfgOverrides {
-		overrides[key] = Override{Description: cfgOverride.Description}
+		overrides[key] = Override{
+			Description:   cfgOverride.Description,
+			JSONString:    cfgOverride.JSONString,
+			MultiType:     cfgOverride.MultiType,
+			Computability: cfgOverride.Computability,
+		}
 	}
 
 	return overrides
@@ -100,6 +100,165 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 				},
 			},
 		},
+		"operation options": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							Path:     "/resources",
+							Method:   "POST",
+							Envelope: "jsonapi",
+						},
+						Read: &config.OpenApiSpecLocation{
+							Path:         "/resources/{resource_id}",
+							Method:       "GET",
+							Envelope:     "jsonapi",
+							ResponsePath: "result.resource",
+						},
+						Update: &config.OpenApiSpecLocation{
+							Path:         "/resources/{resource_id}",
+							Method:       "PUT",
+							RequestPath:  "resource",
+							ResponseCode: "202",
+							MediaType:    "application/vnd.resource+json",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					Put: &high.Operation{
+						Description: "update op here",
+						OperationId: "update_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					UpdateOp: &high.Operation{
+						Description: "update op here",
+						OperationId: "update_resource",
+					},
+					CreateOpOptions: explorer.OperationOptions{
+						RequestPath:  []string{"data", "attributes"},
+						ResponsePath: []string{"data", "attributes"},
+					},
+					ReadOpOptions: explorer.OperationOptions{
+						RequestPath:  []string{"data", "attributes"},
+						ResponsePath: []string{"result", "resource"},
+					},
+					UpdateOpOptions: explorer.OperationOptions{
+						RequestPath:  []string{"resource"},
+						ResponseCode: "202",
+						MediaType:    "application/vnd.resource+json",
+					},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
+		"common parameters of each operation path": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							Path:   "/zones/{zone}/resources",
+							Method: "POST",
+						},
+						Read: &config.OpenApiSpecLocation{
+							Path:   "/resources/{resource_id}",
+							Method: "GET",
+						},
+						Delete: &config.OpenApiSpecLocation{
+							Path:   "/zones/{zone}/resources/{resource_id}",
+							Method: "DELETE",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/zones/{zone}/resources": {
+					Parameters: []*high.Parameter{
+						{Name: "zone", In: "path"},
+					},
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+				"/resources/{resource_id}": {
+					Parameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+				},
+				"/zones/{zone}/resources/{resource_id}": {
+					Parameters: []*high.Parameter{
+						{Name: "zone", In: "path"},
+						{Name: "resource_id", In: "path"},
+					},
+					Delete: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					DeleteOp: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+					CommonParameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+					CreateCommonParameters: []*high.Parameter{
+						{Name: "zone", In: "path"},
+					},
+					DeleteCommonParameters: []*high.Parameter{
+						{Name: "zone", In: "path"},
+						{Name: "resource_id", In: "path"},
+					},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
 		"valid alternative CRUD ops - options, head, patch, trace": {
 			config: config.Config{
 				Resources: map[string]config.Resource{
@@ -250,8 +409,130 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 			}),
 			expectedErr: errors.New(`failed to extract 'test_resource.update': method 'FAKE' not found at OpenAPI path '/resources/{resource_id}'`),
 		},
+		"operation ids": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "create_resource",
+						},
+						Read: &config.OpenApiSpecLocation{
+							OperationId: "read_resource",
+						},
+						Delete: &config.OpenApiSpecLocation{
+							Path:   "/resources/{resource_id}",
+							Method: "DELETE",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+				"/resources/{resource_id}": {
+					Parameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					Delete: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					DeleteOp: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+					CommonParameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+					DeleteCommonParameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
+		"non-existent operation id throws error": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "create_resource",
+						},
+						Read: &config.OpenApiSpecLocation{
+							OperationId: "fake_operation",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						OperationId: "create_resource",
+					},
+				},
+			}),
+			expectedErr: errors.New(`failed to extract 'test_resource.read': operation_id 'fake_operation' not found in OpenAPI spec`),
+		},
+		"ambiguous operation id throws error": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "create_resource",
+						},
+						Read: &config.OpenApiSpecLocation{
+							Path:   "/resources/{resource_id}",
+							Method: "GET",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						OperationId: "create_resource",
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						OperationId: "read_resource",
+					},
+					Put: &high.Operation{
+						OperationId: "create_resource",
+					},
+				},
+			}),
+			expectedErr: errors.New(`failed to extract 'test_resource.create': operation_id 'create_resource' is ambiguous, found in OpenAPI spec at: POST /resources, PUT /resources/{resource_id}`),
+		},
 		"schema options pass-through": {
 			config: config.Config{
+				MultiType: config.MultiTypeOptions{
+					Strategy: "widest",
+				},
 				Resources: map[string]config.Resource{
 					"test_resource": {
 						Create: &config.OpenApiSpecLocation{
@@ -272,8 +553,22 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 									"test": {
 										Description: "test description for override",
 									},
+									"test.json": {
+										JSONString: true,
+									},
+									"test.union": {
+										MultiType: "drop",
+									},
+									"test.computed": {
+										Computability: "computed",
+									},
 								},
 							},
+							RecursionOptions: config.RecursionOptions{
+								MaxDepth: 2,
+								Strategy: "drop",
+							},
+							MergeConflicts: "prefer_response",
 						},
 					},
 				},
@@ -312,8 +607,23 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 								"test": {
 									Description: "test description for override",
 								},
+								"test.json": {
+									JSONString: true,
+								},
+								"test.union": {
+									MultiType: "drop",
+								},
+								"test.computed": {
+									Computability: "computed",
+								},
 							},
 						},
+						RecursionOptions: explorer.RecursionOptions{
+							MaxDepth: 2,
+							Strategy: "drop",
+						},
+						MultiTypeStrategy: "widest",
+						MergeConflicts:    "prefer_response",
 					},
 				},
 			},
@@ -341,7 +651,7 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{})); testCase.expectedErr == nil && diff != "" {
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{}, high.Parameter{})); testCase.expectedErr == nil && diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
@@ -437,6 +747,19 @@ func Test_ConfigExplorer_FindDataSources(t *testing.T) {
 			pathItems:   orderedmap.ToOrderedMap(map[string]*high.PathItem{}),
 			expectedErr: errors.New(`failed to extract 'test_resource.read': path '/fakepath' not found in OpenAPI spec`),
 		},
+		"non-existent operation id throws error": {
+			config: config.Config{
+				DataSources: map[string]config.DataSource{
+					"test_resource": {
+						Read: &config.OpenApiSpecLocation{
+							OperationId: "fake_operation",
+						},
+					},
+				},
+			},
+			pathItems:   orderedmap.ToOrderedMap(map[string]*high.PathItem{}),
+			expectedErr: errors.New(`failed to extract 'test_resource.read': operation_id 'fake_operation' not found in OpenAPI spec`),
+		},
 		"non-existent method throws error": {
 			config: config.Config{
 				DataSources: map[string]config.DataSource{
@@ -4,6 +4,8 @@
 package explorer
 
 import (
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
@@ -15,33 +17,109 @@ type Explorer interface {
 	FindDataSources() (map[string]DataSource, error)
 }
 
+// CandidateExplorer is implemented by explorers that discover resources and data sources without a generator config, returning the
+// OpenAPI locations of the operations found, which can be written to a new generator config.
+type CandidateExplorer interface {
+	Explorer
+	FindResourceCandidates() ([]ResourceCandidate, error)
+	FindDataSourceCandidates() ([]DataSourceCandidate, error)
+}
+
+// ResourceCandidate is a resource discovered by a CandidateExplorer, with the locations of the operations found. An ambiguous
+// candidate is missing operations required for a resource, and isn't returned by FindResources.
+type ResourceCandidate struct {
+	Name     string
+	Resource Resource
+	Create   *config.OpenApiSpecLocation
+	Read     *config.OpenApiSpecLocation
+	Update   *config.OpenApiSpecLocation
+	Delete   *config.OpenApiSpecLocation
+
+	// Ambiguous is the reason the candidate isn't a valid resource, or empty if it is.
+	Ambiguous string
+
+	// Ranking contains every candidate operation for each resource operation ("create", "read", "update", and "delete"), in
+	// order of preference. The first candidate is the operation that was chosen.
+	Ranking map[string][]OperationCandidate
+}
+
+// OperationCandidate is an operation that can be used for a resource operation, with the reason it's a candidate.
+type OperationCandidate struct {
+	Location config.OpenApiSpecLocation
+	Reason   string
+}
+
+// DataSourceCandidate is a data source discovered by a CandidateExplorer, with the location of the read operation.
+type DataSourceCandidate struct {
+	Name       string
+	DataSource DataSource
+	Read       *config.OpenApiSpecLocation
+}
+
 // Resource contains CRUD operations and schema options for configuration.
+//
+// CommonParameters are the parameters defined on the path item of the read operation, while the parameters defined on the path
+// items of the other operations are stored in CreateCommonParameters, UpdateCommonParameters, and DeleteCommonParameters.
 type Resource struct {
-	CreateOp         *high.Operation
-	ReadOp           *high.Operation
-	UpdateOp         *high.Operation
-	DeleteOp         *high.Operation
-	CommonParameters []*high.Parameter
-	SchemaOptions    SchemaOptions
+	CreateOp               *high.Operation
+	ReadOp                 *high.Operation
+	UpdateOp               *high.Operation
+	DeleteOp               *high.Operation
+	CommonParameters       []*high.Parameter
+	CreateCommonParameters []*high.Parameter
+	UpdateCommonParameters []*high.Parameter
+	DeleteCommonParameters []*high.Parameter
+	CreateOpOptions        OperationOptions
+	ReadOpOptions          OperationOptions
+	UpdateOpOptions        OperationOptions
+	SchemaOptions          SchemaOptions
 }
 
 // DataSource contains a Read operation and schema options for configuration.
 type DataSource struct {
 	ReadOp           *high.Operation
 	CommonParameters []*high.Parameter
+	ReadOpOptions    OperationOptions
 	SchemaOptions    SchemaOptions
 }
 
 // Provider contains a name and a schema.
 type Provider struct {
-	Name        string
-	SchemaProxy *base.SchemaProxy
-	Ignores     []string
+	Name              string
+	SchemaProxy       *base.SchemaProxy
+	Ignores           []string
+	MultiTypeStrategy string
+}
+
+// OperationOptions contains options for mapping the request and response body schemas of an operation.
+type OperationOptions struct {
+	// RequestPath is a list of property names, selecting a nested schema of the request body to map.
+	RequestPath []string
+	// ResponsePath is a list of property names, selecting a nested schema of the response body to map.
+	ResponsePath []string
+	// ResponseCode is the response code of the response body to map.
+	ResponseCode string
+	// MediaType is the media type of the request and response bodies to map.
+	MediaType string
 }
 
 type SchemaOptions struct {
-	Ignores          []string
-	AttributeOptions AttributeOptions
+	Ignores           []string
+	AttributeOptions  AttributeOptions
+	ParameterOptions  ParameterOptions
+	RecursionOptions  RecursionOptions
+	MultiTypeStrategy string
+	MergeConflicts    string
+}
+
+type ParameterOptions struct {
+	Headers bool
+	Cookies bool
+}
+
+type RecursionOptions struct {
+	MaxDepth int
+	Strategy string
 }
 
 type AttributeOptions struct {
@@ -50,5 +128,8 @@ type AttributeOptions struct {
 }
 
 type Override struct {
-	Description string
+	Description   string
+	JSONString    bool
+	MultiType     string
+	Computability string
 }
@@ -26,10 +26,22 @@ func mergeParameters(commonParameters []*high.Parameter, operation *high.Operati
 	return mergedParameters
 }
 
+func (e *Resource) CreateOpParameters() []*high.Parameter {
+	return mergeParameters(e.CreateCommonParameters, e.CreateOp)
+}
+
 func (e *Resource) ReadOpParameters() []*high.Parameter {
 	return mergeParameters(e.CommonParameters, e.ReadOp)
 }
 
+func (e *Resource) UpdateOpParameters() []*high.Parameter {
+	return mergeParameters(e.UpdateCommonParameters, e.UpdateOp)
+}
+
+func (e *Resource) DeleteOpParameters() []*high.Parameter {
+	return mergeParameters(e.DeleteCommonParameters, e.DeleteOp)
+}
+
 func (e *DataSource) ReadOpParameters() []*high.Parameter {
 	return mergeParameters(e.CommonParameters, e.ReadOp)
 }
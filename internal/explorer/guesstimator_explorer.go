@@ -8,16 +8,20 @@ import (
 	"fmt"
 	"path"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 )
 
-var _ Explorer = guesstimatorExplorer{}
+var _ CandidateExplorer = guesstimatorExplorer{}
 
 // guesstimatorExplorer is an experimental explorer that reads an OpenAPI specification without any configuration and attempts to
-// discover resources and data sources based on a naming convention. It's not currently in-use in the OpenAPI spec generator.
+// discover resources and data sources based on a naming convention. It's used by the init command to scaffold a generator config.
 type guesstimatorExplorer struct {
 	spec high.Document
 }
@@ -26,26 +30,134 @@ type guesstimatorExplorer struct {
 //   - Example: /users/{username} = MATCH
 var pathParameterRegex = regexp.MustCompile(`{.*}`)
 
+const (
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+
+	collectionPathKind = "collection"
+	identityPathKind   = "identity"
+	singletonPathKind  = "singleton"
+)
+
+// resourceOperationNames are the operations of a resource, in the order they are written to a generator config.
+var resourceOperationNames = []string{operationCreate, operationRead, operationUpdate, operationDelete}
+
+// operationRule is a convention for finding the operation of a resource, like a POST operation on the collection path for create.
+type operationRule struct {
+	method string
+
+	// pathKind is either "collection", "identity", or "singleton". Singleton operations are on the collection path.
+	pathKind string
+
+	// reason explains why an operation that matches the rule is a candidate.
+	reason string
+}
+
+// resourceOperationRules are the conventions for each operation of a resource, in order of preference.
+var resourceOperationRules = map[string][]operationRule{
+	operationCreate: {
+		{method: "post", pathKind: collectionPathKind, reason: "POST on the collection path creates an item with a server-assigned ID"},
+		{method: "put", pathKind: identityPathKind, reason: "PUT on the identity path creates the item idempotently, with a client-assigned ID"},
+	},
+	operationRead: {
+		{method: "get", pathKind: identityPathKind, reason: "GET on the identity path reads the item"},
+	},
+	operationUpdate: {
+		{method: "put", pathKind: identityPathKind, reason: "PUT on the identity path replaces the item"},
+		{method: "patch", pathKind: identityPathKind, reason: "PATCH on the identity path partially updates the item"},
+	},
+	operationDelete: {
+		{method: "delete", pathKind: identityPathKind, reason: "DELETE on the identity path deletes the item"},
+	},
+}
+
+// singletonOperationRules are the conventions for each operation of a singleton resource, like /settings, which doesn't have an
+// identity path and always exists. Creating the resource sets the singleton, the same as an update.
+var singletonOperationRules = map[string][]operationRule{
+	operationCreate: {
+		{method: "put", pathKind: singletonPathKind, reason: "PUT on the singleton path replaces the singleton"},
+		{method: "patch", pathKind: singletonPathKind, reason: "PATCH on the singleton path partially updates the singleton"},
+	},
+	operationRead: {
+		{method: "get", pathKind: singletonPathKind, reason: "GET on the singleton path reads the singleton"},
+	},
+	operationUpdate: {
+		{method: "put", pathKind: singletonPathKind, reason: "PUT on the singleton path replaces the singleton"},
+		{method: "patch", pathKind: singletonPathKind, reason: "PATCH on the singleton path partially updates the singleton"},
+	},
+	operationDelete: {
+		{method: "delete", pathKind: singletonPathKind, reason: "DELETE on the singleton path resets the singleton"},
+	},
+}
+
+// describeRules returns the methods and paths of rules, like "POST on the collection path or PUT on the identity path".
+func describeRules(rules []operationRule) string {
+	descriptions := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		descriptions = append(descriptions, fmt.Sprintf("%s on the %s path", strings.ToUpper(rule.method), rule.pathKind))
+	}
+
+	return strings.Join(descriptions, " or ")
+}
+
+// rankedOperation is an operation that matched an operationRule.
+type rankedOperation struct {
+	op        *high.Operation
+	params    []*high.Parameter
+	candidate OperationCandidate
+}
+
+// location returns the location of the operation, or nil if there is no operation.
+func (r rankedOperation) location() *config.OpenApiSpecLocation {
+	if r.op == nil {
+		return nil
+	}
+
+	location := r.candidate.Location
+	return &location
+}
+
 type resourceOperations struct {
 	// IdentityOps are operations (GET, PUT, POST, DELETE, etc.) on a path that ends with a parameter: /path/{id}
 	IdentityOps map[string]*high.Operation
 
 	// CollectionOps are operations (GET, PUT, POST, DELETE, etc.) on a path that don't end with a parameter: /path
 	CollectionOps map[string]*high.Operation
+
+	// IdentityParameters are the common parameters defined on each identity path item, by path.
+	IdentityParameters map[string][]*high.Parameter
+
+	// CollectionParameters are the common parameters defined on each collection path item, by path.
+	CollectionParameters map[string][]*high.Parameter
+
+	// IdentityPaths are the API paths of the identity operations, by method. Different identity paths can be grouped together,
+	// like /path/{id} and /path/{name}.
+	IdentityPaths map[string]string
+
+	// CollectionPaths are the API paths of the collection operations, by method.
+	CollectionPaths map[string]string
 }
 
 // As the name suggests, the Guesstimator evaluates an OpenAPIv3 spec and will return
 // Resources, DataSources, and their respective names, based on [RESTful conventions].
 //
 // FindResources will group API paths together into collection operations and identity operations, then use the HTTP method to
-// determine how to map to a terraform resource. A valid Resource will have a POST collection operation, GET identity operation, and
-// a DELETE identity operation. The name of the Resource is a combination of the preceding paths, excluding any path parameters.
+// determine how to map to a terraform resource. A valid Resource will have a POST collection operation (or a PUT identity
+// operation), GET identity operation, and a DELETE identity operation. The update operation is a PUT identity operation, or a PATCH
+// identity operation. The name of the Resource is a combination of the preceding paths, excluding any path parameters.
 // An example of a valid Resource would be:
 //   - POST /org/{org_id}/users = Create operation for `org_users` resource
 //   - GET /org/{org_id}/users/{id} = Read operation for `org_users` resource
 //   - PUT /org/{org_id}/users/{id} = Update operation for `org_users` resource
 //   - DELETE /org/{org_id}/users/{id} = Delete operation for `org_users` resource
 //
+// A path without an identity path, that has GET and PUT (or PATCH) operations, is a singleton Resource. The PUT operation is used
+// for both create and update, and a DELETE operation is optional. An example of a valid singleton Resource would be:
+//   - PUT /org/{org_id}/settings = Create and update operation for `org_settings` resource
+//   - GET /org/{org_id}/settings = Read operation for `org_settings` resource
+//
 // FindDataSources will group API paths together into collection operations and identity operations, then use the HTTP method to
 // determine how to map to a terraform data source. A valid DataSource has a GET identity operation or a GET collection operation.
 // The name of the DataSource is a combination of the preceding paths, excluding any path parameters, with an added suffix of "_collection"
@@ -54,8 +166,12 @@ type resourceOperations struct {
 //   - GET /org/{org_id}/users = Read operation for `org_users_collection` data source
 //   - GET /org/{org_id}/users/{id} = Read operation for `org_users` data source
 //
+// FindResourceCandidates and FindDataSourceCandidates return the same resources and data sources with the location of each
+// operation. Paths with a POST collection operation or a DELETE identity operation that aren't a valid Resource are returned as
+// ambiguous resource candidates.
+//
 // [RESTful conventions]: https://swagger.io/resources/articles/best-practices-in-api-design/
-func NewGuesstimatorExplorer(spec high.Document) Explorer {
+func NewGuesstimatorExplorer(spec high.Document) CandidateExplorer {
 	return guesstimatorExplorer{
 		spec: spec,
 	}
@@ -73,28 +189,17 @@ func (e guesstimatorExplorer) FindProvider() (Provider, error) {
 func (e guesstimatorExplorer) FindResources() (map[string]Resource, error) {
 	resourcesMap := map[string]Resource{}
 
-	groupedResourceOperations := e.groupPathItems()
-	for name, group := range groupedResourceOperations {
-		if group.CollectionOps == nil || group.IdentityOps == nil {
-			continue
-		}
+	candidates, err := e.FindResourceCandidates()
+	if err != nil {
+		return nil, err
+	}
 
-		if group.IdentityOps["get"] == nil || group.IdentityOps["delete"] == nil || group.CollectionOps["post"] == nil {
+	for _, candidate := range candidates {
+		if candidate.Ambiguous != "" {
 			continue
 		}
 
-		// Fallback to POST on identity
-		createOp := group.CollectionOps["post"]
-		if createOp == nil {
-			createOp = group.IdentityOps["post"]
-		}
-
-		resourcesMap[name] = Resource{
-			CreateOp: createOp,
-			ReadOp:   group.IdentityOps["get"],
-			UpdateOp: group.IdentityOps["put"],
-			DeleteOp: group.IdentityOps["delete"],
-		}
+		resourcesMap[candidate.Name] = candidate.Resource
 	}
 
 	return resourcesMap, nil
@@ -106,23 +211,189 @@ func (e guesstimatorExplorer) FindResources() (map[string]Resource, error) {
 func (e guesstimatorExplorer) FindDataSources() (map[string]DataSource, error) {
 	dataSourcesMap := map[string]DataSource{}
 
+	candidates, err := e.FindDataSourceCandidates()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		dataSourcesMap[candidate.Name] = candidate.DataSource
+	}
+
+	return dataSourcesMap, nil
+}
+
+// FindResourceCandidates returns all resources, sorted by name, along with ambiguous candidates that are missing a required
+// operation. The candidate operations for each resource operation are ranked by the conventions in resourceOperationRules, or
+// singletonOperationRules for singleton paths, and the first candidate is chosen.
+func (e guesstimatorExplorer) FindResourceCandidates() ([]ResourceCandidate, error) {
+	candidates := []ResourceCandidate{}
+
 	groupedResourceOperations := e.groupPathItems()
-	for name, group := range groupedResourceOperations {
-		if group.CollectionOps == nil || group.IdentityOps == nil {
+	for _, name := range sortedGroupNames(groupedResourceOperations) {
+		group := groupedResourceOperations[name]
+
+		rules := resourceOperationRules
+		required := []string{operationCreate, operationRead, operationDelete}
+		if group.isSingleton() {
+			rules = singletonOperationRules
+			required = []string{operationCreate, operationRead}
+		}
+
+		ranked := map[string][]rankedOperation{}
+		for _, opName := range resourceOperationNames {
+			ranked[opName] = group.rankOperations(rules[opName])
+		}
+
+		// Paths without any operation that creates or deletes are read-only, and only found as data sources
+		if len(ranked[operationCreate]) == 0 && len(ranked[operationDelete]) == 0 {
 			continue
 		}
 
+		candidate := ResourceCandidate{
+			Name:    name,
+			Ranking: map[string][]OperationCandidate{},
+		}
+
+		missing := []string{}
+		chosen := map[string]rankedOperation{}
+		for _, opName := range resourceOperationNames {
+			if len(ranked[opName]) == 0 {
+				if slices.Contains(required, opName) {
+					missing = append(missing, fmt.Sprintf("%s (%s)", opName, describeRules(rules[opName])))
+				}
+				continue
+			}
+
+			chosen[opName] = ranked[opName][0]
+			for _, rankedOp := range ranked[opName] {
+				candidate.Ranking[opName] = append(candidate.Ranking[opName], rankedOp.candidate)
+			}
+		}
+
+		candidate.Resource = Resource{
+			CreateOp:               chosen[operationCreate].op,
+			ReadOp:                 chosen[operationRead].op,
+			UpdateOp:               chosen[operationUpdate].op,
+			DeleteOp:               chosen[operationDelete].op,
+			CommonParameters:       chosen[operationRead].params,
+			CreateCommonParameters: chosen[operationCreate].params,
+			UpdateCommonParameters: chosen[operationUpdate].params,
+			DeleteCommonParameters: chosen[operationDelete].params,
+		}
+		candidate.Create = chosen[operationCreate].location()
+		candidate.Read = chosen[operationRead].location()
+		candidate.Update = chosen[operationUpdate].location()
+		candidate.Delete = chosen[operationDelete].location()
+
+		if len(missing) > 0 {
+			candidate.Ambiguous = fmt.Sprintf("missing %s", strings.Join(missing, ", "))
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// FindDataSourceCandidates returns all data sources, sorted by name.
+func (e guesstimatorExplorer) FindDataSourceCandidates() ([]DataSourceCandidate, error) {
+	candidates := []DataSourceCandidate{}
+
+	groupedResourceOperations := e.groupPathItems()
+	for _, name := range sortedGroupNames(groupedResourceOperations) {
+		group := groupedResourceOperations[name]
+
 		if group.IdentityOps["get"] != nil {
 			// Combine all schemas into something that can be translated to framework IR
-			dataSourcesMap[name+"_by_id"] = DataSource{ReadOp: group.IdentityOps["get"]}
+			candidates = append(candidates, DataSourceCandidate{
+				Name: name + "_by_id",
+				DataSource: DataSource{
+					ReadOp:           group.IdentityOps["get"],
+					CommonParameters: group.IdentityParameters[group.IdentityPaths["get"]],
+				},
+				Read: operationLocation(group.IdentityPaths, "get", group.IdentityOps["get"]),
+			})
 		}
 
 		if group.CollectionOps["get"] != nil {
-			dataSourcesMap[name+"_collection"] = DataSource{ReadOp: group.CollectionOps["get"]}
+			candidates = append(candidates, DataSourceCandidate{
+				Name: name + "_collection",
+				DataSource: DataSource{
+					ReadOp:           group.CollectionOps["get"],
+					CommonParameters: group.CollectionParameters[group.CollectionPaths["get"]],
+				},
+				Read: operationLocation(group.CollectionPaths, "get", group.CollectionOps["get"]),
+			})
 		}
 	}
 
-	return dataSourcesMap, nil
+	return candidates, nil
+}
+
+// operationLocation returns the location of an operation in a generator config, or nil if there is no operation.
+func operationLocation(paths map[string]string, method string, op *high.Operation) *config.OpenApiSpecLocation {
+	if op == nil {
+		return nil
+	}
+
+	return &config.OpenApiSpecLocation{
+		Path:   paths[method],
+		Method: strings.ToUpper(method),
+	}
+}
+
+// isSingleton returns true if the operations are on a single path without an identity path, like /settings, that can be read and
+// updated, but not created with POST.
+func (g resourceOperations) isSingleton() bool {
+	if len(g.IdentityOps) > 0 || g.CollectionOps["post"] != nil || g.CollectionOps["get"] == nil {
+		return false
+	}
+
+	return g.CollectionOps["put"] != nil || g.CollectionOps["patch"] != nil
+}
+
+// rankOperations returns every operation that matches a rule, in the order of the rules.
+func (g resourceOperations) rankOperations(rules []operationRule) []rankedOperation {
+	ranked := []rankedOperation{}
+
+	for _, rule := range rules {
+		ops, paths, params := g.CollectionOps, g.CollectionPaths, g.CollectionParameters
+		if rule.pathKind == identityPathKind {
+			ops, paths, params = g.IdentityOps, g.IdentityPaths, g.IdentityParameters
+		}
+
+		op := ops[rule.method]
+		if op == nil {
+			continue
+		}
+
+		// Common parameters are from the path item of the operation, as grouped operations can be on different paths
+		ranked = append(ranked, rankedOperation{
+			op:     op,
+			params: params[paths[rule.method]],
+			candidate: OperationCandidate{
+				Location: config.OpenApiSpecLocation{
+					Path:   paths[rule.method],
+					Method: strings.ToUpper(rule.method),
+				},
+				Reason: rule.reason,
+			},
+		})
+	}
+
+	return ranked
+}
+
+// sortedGroupNames returns the names of all grouped operations in alphabetical order.
+func sortedGroupNames(groups map[string]resourceOperations) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
 }
 
 // groupPathItems groups all operations for potential TF resource/data source
@@ -133,20 +404,33 @@ func (e guesstimatorExplorer) groupPathItems() map[string]resourceOperations {
 	for pair := range orderedmap.Iterate(context.TODO(), e.spec.Paths.PathItems) {
 		resource, isIdentity := convertPathToResourceName(pair.Key())
 
-		_, ok := groups[resource]
+		group, ok := groups[resource]
 		if !ok {
-			groups[resource] = resourceOperations{
-				IdentityOps:   map[string]*high.Operation{},
-				CollectionOps: map[string]*high.Operation{},
+			group = resourceOperations{
+				IdentityOps:          map[string]*high.Operation{},
+				CollectionOps:        map[string]*high.Operation{},
+				IdentityParameters:   map[string][]*high.Parameter{},
+				CollectionParameters: map[string][]*high.Parameter{},
+				IdentityPaths:        map[string]string{},
+				CollectionPaths:      map[string]string{},
 			}
 		}
 
+		if isIdentity {
+			group.IdentityParameters[pair.Key()] = pair.Value().Parameters
+		} else {
+			group.CollectionParameters[pair.Key()] = pair.Value().Parameters
+		}
+		groups[resource] = group
+
 		ops := pair.Value().GetOperations()
 		for opPair := range orderedmap.Iterate(context.TODO(), ops) {
 			if isIdentity {
 				groups[resource].IdentityOps[opPair.Key()] = opPair.Value()
+				groups[resource].IdentityPaths[opPair.Key()] = pair.Key()
 			} else {
 				groups[resource].CollectionOps[opPair.Key()] = opPair.Value()
+				groups[resource].CollectionPaths[opPair.Key()] = pair.Key()
 			}
 		}
 	}
@@ -6,8 +6,10 @@ package explorer_test
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 
+	"github.com/google/go-cmp/cmp"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 )
@@ -55,6 +57,48 @@ func Test_GuesstimatorExplorer_FindResources(t *testing.T) {
 			}),
 			expectedResources: []string{"verycool_verynice_resources"},
 		},
+		"valid resource combo - PATCHbyID update": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{},
+				},
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Patch:  &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"resources"},
+		},
+		"valid resource combo - PUTbyID create": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Put:    &high.Operation{},
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"resources"},
+		},
+		"valid singleton resource combo": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/verycool/{id}/settings": {
+					Get: &high.Operation{},
+					Put: &high.Operation{},
+				},
+			}),
+			expectedResources: []string{"verycool_settings"},
+		},
+		"invalid singleton resource combo - GET,POST": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/settings": {
+					Get:  &high.Operation{},
+					Post: &high.Operation{},
+					Put:  &high.Operation{},
+				},
+			}),
+			expectedResources: []string{},
+		},
 		"invalid resource combo - POST,DELETEbyID": {
 			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
 				"/resources": {
@@ -220,3 +264,337 @@ func Test_GuesstimatorExplorer_FindDataSources(t *testing.T) {
 		})
 	}
 }
+
+func Test_GuesstimatorExplorer_FindResourceCandidates(t *testing.T) {
+	t.Parallel()
+
+	// candidate is a resource candidate without the operations, which are compared by location
+	type candidate struct {
+		Name      string
+		Create    *config.OpenApiSpecLocation
+		Read      *config.OpenApiSpecLocation
+		Update    *config.OpenApiSpecLocation
+		Delete    *config.OpenApiSpecLocation
+		Ambiguous string
+	}
+
+	testCases := map[string]struct {
+		pathItems          *orderedmap.Map[string, *high.PathItem]
+		expectedCandidates []candidate
+	}{
+		"valid resource with update": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{},
+				},
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Put:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:   "resources",
+					Create: &config.OpenApiSpecLocation{Path: "/resources", Method: "POST"},
+					Read:   &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					Update: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+					Delete: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+				},
+			},
+		},
+		"grouped identity paths": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{},
+				},
+				"/resources/{name}": {
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:   "resources",
+					Create: &config.OpenApiSpecLocation{Path: "/resources", Method: "POST"},
+					Read:   &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					Delete: &config.OpenApiSpecLocation{Path: "/resources/{name}", Method: "DELETE"},
+				},
+			},
+		},
+		"ambiguous - missing read and delete": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/login": {
+					Post: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:      "resources_login",
+					Create:    &config.OpenApiSpecLocation{Path: "/resources/login", Method: "POST"},
+					Ambiguous: "missing read (GET on the identity path), delete (DELETE on the identity path)",
+				},
+			},
+		},
+		"ambiguous - missing create": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:      "resources",
+					Read:      &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					Delete:    &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+					Ambiguous: "missing create (POST on the collection path or PUT on the identity path)",
+				},
+			},
+		},
+		"update with PATCH": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{},
+				},
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Patch:  &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:   "resources",
+					Create: &config.OpenApiSpecLocation{Path: "/resources", Method: "POST"},
+					Read:   &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					Update: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PATCH"},
+					Delete: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+				},
+			},
+		},
+		"create with PUT on the identity path": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Get:    &high.Operation{},
+					Put:    &high.Operation{},
+					Patch:  &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:   "resources",
+					Create: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+					Read:   &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+					Update: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+					Delete: &config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+				},
+			},
+		},
+		"singleton": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/org/{org_id}/settings": {
+					Get:   &high.Operation{},
+					Patch: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:   "org_settings",
+					Create: &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "PATCH"},
+					Read:   &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "GET"},
+					Update: &config.OpenApiSpecLocation{Path: "/org/{org_id}/settings", Method: "PATCH"},
+				},
+			},
+		},
+		"singleton with delete": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/settings": {
+					Get:    &high.Operation{},
+					Put:    &high.Operation{},
+					Patch:  &high.Operation{},
+					Delete: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{
+				{
+					Name:   "settings",
+					Create: &config.OpenApiSpecLocation{Path: "/settings", Method: "PUT"},
+					Read:   &config.OpenApiSpecLocation{Path: "/settings", Method: "GET"},
+					Update: &config.OpenApiSpecLocation{Path: "/settings", Method: "PUT"},
+					Delete: &config.OpenApiSpecLocation{Path: "/settings", Method: "DELETE"},
+				},
+			},
+		},
+		"read-only paths aren't candidates": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Get: &high.Operation{},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{},
+				},
+			}),
+			expectedCandidates: []candidate{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			explorer := explorer.NewGuesstimatorExplorer(high.Document{Paths: &high.Paths{PathItems: testCase.pathItems}})
+			resourceCandidates, err := explorer.FindResourceCandidates()
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			got := []candidate{}
+			for _, resourceCandidate := range resourceCandidates {
+				got = append(got, candidate{
+					Name:      resourceCandidate.Name,
+					Create:    resourceCandidate.Create,
+					Read:      resourceCandidate.Read,
+					Update:    resourceCandidate.Update,
+					Delete:    resourceCandidate.Delete,
+					Ambiguous: resourceCandidate.Ambiguous,
+				})
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedCandidates); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_GuesstimatorExplorer_FindResourceCandidates_Ranking(t *testing.T) {
+	t.Parallel()
+
+	pathItems := orderedmap.ToOrderedMap(map[string]*high.PathItem{
+		"/resources": {
+			Post: &high.Operation{},
+		},
+		"/resources/{resource_id}": {
+			Get:    &high.Operation{},
+			Put:    &high.Operation{},
+			Patch:  &high.Operation{},
+			Delete: &high.Operation{},
+		},
+	})
+
+	expectedRanking := map[string][]explorer.OperationCandidate{
+		"create": {
+			{
+				Location: config.OpenApiSpecLocation{Path: "/resources", Method: "POST"},
+				Reason:   "POST on the collection path creates an item with a server-assigned ID",
+			},
+			{
+				Location: config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+				Reason:   "PUT on the identity path creates the item idempotently, with a client-assigned ID",
+			},
+		},
+		"read": {
+			{
+				Location: config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "GET"},
+				Reason:   "GET on the identity path reads the item",
+			},
+		},
+		"update": {
+			{
+				Location: config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PUT"},
+				Reason:   "PUT on the identity path replaces the item",
+			},
+			{
+				Location: config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "PATCH"},
+				Reason:   "PATCH on the identity path partially updates the item",
+			},
+		},
+		"delete": {
+			{
+				Location: config.OpenApiSpecLocation{Path: "/resources/{resource_id}", Method: "DELETE"},
+				Reason:   "DELETE on the identity path deletes the item",
+			},
+		},
+	}
+
+	explorer := explorer.NewGuesstimatorExplorer(high.Document{Paths: &high.Paths{PathItems: pathItems}})
+	resourceCandidates, err := explorer.FindResourceCandidates()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+
+	if len(resourceCandidates) != 1 {
+		t.Fatalf("expected 1 resource candidate, found %d resource candidates", len(resourceCandidates))
+	}
+
+	if diff := cmp.Diff(resourceCandidates[0].Ranking, expectedRanking); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func Test_GuesstimatorExplorer_CommonParameters(t *testing.T) {
+	t.Parallel()
+
+	commonParameter := func(name string) []*high.Parameter {
+		return []*high.Parameter{{Name: name, In: "path"}}
+	}
+	parameterNames := func(params []*high.Parameter) []string {
+		names := []string{}
+		for _, param := range params {
+			names = append(names, param.Name)
+		}
+		return names
+	}
+
+	// Both identity paths are grouped into the same resource, but each has its own common parameters
+	pathItems := orderedmap.New[string, *high.PathItem]()
+	pathItems.Set("/pets", &high.PathItem{
+		Post: &high.Operation{},
+	})
+	pathItems.Set("/pets/{petId}", &high.PathItem{
+		Get:        &high.Operation{},
+		Parameters: commonParameter("petId"),
+	})
+	pathItems.Set("/pets/{petName}", &high.PathItem{
+		Delete:     &high.Operation{},
+		Parameters: commonParameter("petName"),
+	})
+
+	explorer := explorer.NewGuesstimatorExplorer(high.Document{Paths: &high.Paths{PathItems: pathItems}})
+	resources, err := explorer.FindResources()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+
+	resource, ok := resources["pets"]
+	if !ok {
+		t.Fatalf("pets resource not found")
+	}
+
+	if diff := cmp.Diff(parameterNames(resource.CommonParameters), []string{"petId"}); diff != "" {
+		t.Errorf("unexpected difference in read common parameters: %s", diff)
+	}
+
+	if diff := cmp.Diff(parameterNames(resource.DeleteCommonParameters), []string{"petName"}); diff != "" {
+		t.Errorf("unexpected difference in delete common parameters: %s", diff)
+	}
+
+	dataSources, err := explorer.FindDataSources()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+
+	dataSource, ok := dataSources["pets_by_id"]
+	if !ok {
+		t.Fatalf("pets_by_id data source not found")
+	}
+
+	if diff := cmp.Diff(parameterNames(dataSource.CommonParameters), []string{"petId"}); diff != "" {
+		t.Errorf("unexpected difference in data source common parameters: %s", diff)
+	}
+}
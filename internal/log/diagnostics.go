@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+)
+
+const (
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+)
+
+// Diagnostic is a warning or error that was logged while generating a provider code spec, with the structured logging attributes
+// that identify where it came from.
+type Diagnostic struct {
+	// Severity is either SeverityWarning or SeverityError
+	Severity string `json:"severity"`
+
+	// Code identifies the class of diagnostic, if known.
+	Code diagnostic.Code `json:"code,omitempty"`
+
+	// Resource, DataSource, and Provider contain the name of the Terraform object being mapped, if any.
+	Resource   string `json:"resource,omitempty"`
+	DataSource string `json:"data_source,omitempty"`
+	Provider   string `json:"provider,omitempty"`
+
+	// AttributePath is the OAS property path, joined with ".", like `spec.containers`.
+	AttributePath string `json:"attribute_path,omitempty"`
+
+	// File, Line, and Column are the location in the OAS file, if known.
+	File   string `json:"file,omitempty"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+
+	// JSONPointer is the JSON pointer to the schema in the OAS file, like `#/components/schemas/Pod/properties/spec`, if known.
+	JSONPointer string `json:"json_pointer,omitempty"`
+
+	// Excerpt is a short excerpt of the source line in the OAS file, if known.
+	Excerpt string `json:"excerpt,omitempty"`
+
+	// Message is the log message, with the error appended if one was logged.
+	Message string `json:"message"`
+}
+
+// Diagnostics collects all diagnostics logged by a DiagnosticsHandler, and is safe for concurrent use.
+type Diagnostics struct {
+	mu          sync.Mutex
+	diagnostics []Diagnostic
+}
+
+// All returns every diagnostic collected, in the order they were logged.
+func (d *Diagnostics) All() []Diagnostic {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]Diagnostic{}, d.diagnostics...)
+}
+
+func (d *Diagnostics) append(diagnostic Diagnostic) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.diagnostics = append(d.diagnostics, diagnostic)
+}
+
+// DiagnosticsHandler is a slog.Handler that collects every warning and error log record as a Diagnostic, before passing the
+// record to the next handler. Groups are passed to the next handler, but aren't used when building diagnostics.
+type DiagnosticsHandler struct {
+	next        slog.Handler
+	diagnostics *Diagnostics
+	attrs       []slog.Attr
+}
+
+// NewDiagnosticsHandler returns a DiagnosticsHandler that collects diagnostics into diagnostics and passes all records to next.
+func NewDiagnosticsHandler(next slog.Handler, diagnostics *Diagnostics) *DiagnosticsHandler {
+	return &DiagnosticsHandler{
+		next:        next,
+		diagnostics: diagnostics,
+	}
+}
+
+// Enabled implements slog.Handler.
+func (h *DiagnosticsHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelWarn || h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *DiagnosticsHandler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level >= slog.LevelWarn {
+		h.diagnostics.append(newDiagnostic(h.attrs, record))
+	}
+
+	if !h.next.Enabled(ctx, record.Level) {
+		return nil
+	}
+
+	return h.next.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *DiagnosticsHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &DiagnosticsHandler{
+		next:        h.next.WithAttrs(attrs),
+		diagnostics: h.diagnostics,
+		attrs:       append(slices.Clone(h.attrs), attrs...),
+	}
+}
+
+// WithGroup implements slog.Handler.
+func (h *DiagnosticsHandler) WithGroup(name string) slog.Handler {
+	return &DiagnosticsHandler{
+		next:        h.next.WithGroup(name),
+		diagnostics: h.diagnostics,
+		attrs:       h.attrs,
+	}
+}
+
+// SuppressHandler is a slog.Handler that drops warning log records that are suppressed, before they reach the next handler. Error
+// log records are never suppressed.
+type SuppressHandler struct {
+	next       slog.Handler
+	suppressed func(Diagnostic) bool
+	attrs      []slog.Attr
+}
+
+// NewSuppressHandler returns a SuppressHandler that drops warnings when suppressed returns true for the diagnostic built from the
+// log record, and passes all other records to next.
+func NewSuppressHandler(next slog.Handler, suppressed func(Diagnostic) bool) *SuppressHandler {
+	return &SuppressHandler{
+		next:       next,
+		suppressed: suppressed,
+	}
+}
+
+// Enabled implements slog.Handler.
+func (h *SuppressHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *SuppressHandler) Handle(ctx context.Context, record slog.Record) error {
+	if record.Level == slog.LevelWarn && h.suppressed(newDiagnostic(h.attrs, record)) {
+		return nil
+	}
+
+	return h.next.Handle(ctx, record)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *SuppressHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &SuppressHandler{
+		next:       h.next.WithAttrs(attrs),
+		suppressed: h.suppressed,
+		attrs:      append(slices.Clone(h.attrs), attrs...),
+	}
+}
+
+// WithGroup implements slog.Handler.
+func (h *SuppressHandler) WithGroup(name string) slog.Handler {
+	return &SuppressHandler{
+		next:       h.next.WithGroup(name),
+		suppressed: h.suppressed,
+		attrs:      h.attrs,
+	}
+}
+
+// newDiagnostic builds a Diagnostic from a log record and the attributes of the logger, using the attributes added by the mappers
+// and WarnLogOnError.
+func newDiagnostic(attrs []slog.Attr, record slog.Record) Diagnostic {
+	diag := Diagnostic{
+		Severity: SeverityWarning,
+		Message:  record.Message,
+	}
+	if record.Level >= slog.LevelError {
+		diag.Severity = SeverityError
+	}
+
+	var errValue, param string
+	applyAttr := func(attr slog.Attr) bool {
+		value := attr.Value.Resolve()
+
+		switch attr.Key {
+		case "code":
+			diag.Code = diagnostic.Code(value.String())
+		case "resource":
+			diag.Resource = value.String()
+		case "data_source":
+			diag.DataSource = value.String()
+		case "provider":
+			diag.Provider = value.String()
+		case "oas_path":
+			diag.AttributePath = value.String()
+		case "param":
+			param = value.String()
+		case "oas_file":
+			diag.File = value.String()
+		case "oas_line_number":
+			if value.Kind() == slog.KindInt64 {
+				diag.Line = int(value.Int64())
+			}
+		case "oas_column_number":
+			if value.Kind() == slog.KindInt64 {
+				diag.Column = int(value.Int64())
+			}
+		case "oas_json_pointer":
+			diag.JSONPointer = value.String()
+		case "oas_excerpt":
+			diag.Excerpt = value.String()
+		case "err", "validation_msg", "circular_ref":
+			errValue = value.String()
+		}
+
+		return true
+	}
+
+	for _, attr := range attrs {
+		applyAttr(attr)
+	}
+	record.Attrs(applyAttr)
+
+	// Parameter schema errors have a path relative to the parameter, which is a top-level attribute
+	if param != "" {
+		diag.AttributePath = strings.Trim(fmt.Sprintf("%s.%s", param, diag.AttributePath), ".")
+	}
+
+	if errValue != "" {
+		diag.Message = fmt.Sprintf("%s: %s", diag.Message, errValue)
+	}
+
+	return diag
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package log_test
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+)
+
+func TestDiagnosticsHandler(t *testing.T) {
+	t.Parallel()
+
+	diagnostics := &log.Diagnostics{}
+	logger := slog.New(log.NewDiagnosticsHandler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelWarn}), diagnostics))
+
+	rLogger := logger.With("resource", "pet")
+	rLogger.Info("not collected")
+	log.WarnLogOnError(rLogger, oas.NewSchemaError(diagnostic.WithCode(diagnostic.CodeMultiType, errors.New("unsupported")), 12, "tags", "name"), "dropping attribute from schema")
+	log.WarnLogOnError(rLogger.With("param", "pet_id"), errors.New("invalid param"), "skipping mapping of read operation parameter")
+	log.ErrorLogOnError(logger.With("data_source", "pets"), errors.New("no read operation"), "skipping data source schema mapping")
+
+	expected := []log.Diagnostic{
+		{
+			Severity:      log.SeverityWarning,
+			Code:          diagnostic.CodeMultiType,
+			Resource:      "pet",
+			AttributePath: "tags.name",
+			Line:          12,
+			Message:       "dropping attribute from schema: unsupported",
+		},
+		{
+			Severity:      log.SeverityWarning,
+			Resource:      "pet",
+			AttributePath: "pet_id",
+			Message:       "skipping mapping of read operation parameter: invalid param",
+		},
+		{
+			Severity:   log.SeverityError,
+			DataSource: "pets",
+			Message:    "skipping data source schema mapping: no read operation",
+		},
+	}
+
+	if diff := cmp.Diff(diagnostics.All(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSuppressHandler(t *testing.T) {
+	t.Parallel()
+
+	diagnostics := &log.Diagnostics{}
+	logger := slog.New(log.NewDiagnosticsHandler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelWarn}), diagnostics))
+	logger = slog.New(log.NewSuppressHandler(logger.Handler(), func(diag log.Diagnostic) bool {
+		return diag.Code == diagnostic.CodeMultiType && diag.Resource == "pet"
+	}))
+
+	multiTypeErr := diagnostic.WithCode(diagnostic.CodeMultiType, errors.New("unsupported"))
+	log.WarnLogOnError(logger.With("resource", "pet"), multiTypeErr, "dropping attribute from schema")
+	log.WarnLogOnError(logger.With("resource", "store"), multiTypeErr, "dropping attribute from schema")
+	log.ErrorLogOnError(logger.With("resource", "pet"), multiTypeErr, "skipping resource schema mapping")
+
+	expected := []log.Diagnostic{
+		{
+			Severity: log.SeverityWarning,
+			Code:     diagnostic.CodeMultiType,
+			Resource: "store",
+			Message:  "dropping attribute from schema: unsupported",
+		},
+		{
+			Severity: log.SeverityError,
+			Code:     diagnostic.CodeMultiType,
+			Resource: "pet",
+			Message:  "skipping resource schema mapping: unsupported",
+		},
+	}
+
+	if diff := cmp.Diff(diagnostics.All(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
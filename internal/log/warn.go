@@ -7,6 +7,7 @@ import (
 	"errors"
 	"log/slog"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
 )
 
@@ -16,15 +17,47 @@ func WarnLogOnError(logger *slog.Logger, err error, message string) {
 		return
 	}
 
+	withErrorAttributes(logger, err).Warn(message, "err", err)
+}
+
+// ErrorLogOnError inspects the error type and extracts additional information for structured logging if possible
+func ErrorLogOnError(logger *slog.Logger, err error, message string) {
+	if err == nil {
+		return
+	}
+
+	withErrorAttributes(logger, err).Error(message, "err", err)
+}
+
+// withErrorAttributes returns a logger with the diagnostic code of err, and the OAS path and source location of a SchemaError, if
+// err contains one. The source location includes the file (if not the root OAS file), line, column, JSON pointer, and a short
+// excerpt of the source line.
+func withErrorAttributes(logger *slog.Logger, err error) *slog.Logger {
+	if code := diagnostic.CodeOf(err); code != "" {
+		logger = logger.With("code", code)
+	}
+
 	var schemaErr *oas.SchemaError
 	if errors.As(err, &schemaErr) {
 		if schemaErr.Path() != "" {
 			logger = logger.With("oas_path", schemaErr.Path())
 		}
+		if schemaErr.File() != "" {
+			logger = logger.With("oas_file", schemaErr.File())
+		}
 		if schemaErr.LineNumber() != 0 {
 			logger = logger.With("oas_line_number", schemaErr.LineNumber())
 		}
+		if schemaErr.ColumnNumber() != 0 {
+			logger = logger.With("oas_column_number", schemaErr.ColumnNumber())
+		}
+		if pointer := schemaErr.JSONPointer(); pointer != "" {
+			logger = logger.With("oas_json_pointer", pointer)
+		}
+		if excerpt := schemaErr.SourceExcerpt(); excerpt != "" {
+			logger = logger.With("oas_excerpt", excerpt)
+		}
 	}
 
-	logger.Warn(message, "err", err)
+	return logger
 }
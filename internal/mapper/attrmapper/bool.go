@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceBoolAttribute struct {
@@ -23,20 +25,38 @@ func (a *ResourceBoolAttribute) GetName() string {
 
 func (a *ResourceBoolAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	boolAttribute, ok := mergeAttribute.(*ResourceBoolAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newResourceMergeConflict(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = boolAttribute.Description
 	}
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, boolAttribute.ComputedOptionalRequired)
 
 	return a, nil
 }
 
 func (a *ResourceBoolAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceBoolAttribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.BoolPlanModifier{
+		Custom: frameworkplanmodifiers.BoolPlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
 func (a *ResourceBoolAttribute) ToSpec() resource.Attribute {
 	return resource.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -56,8 +76,11 @@ func (a *DataSourceBoolAttribute) GetName() string {
 
 func (a *DataSourceBoolAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	boolAttribute, ok := mergeAttribute.(*DataSourceBoolAttribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = boolAttribute.Description
 	}
 
@@ -65,7 +88,13 @@ func (a *DataSourceBoolAttribute) Merge(mergeAttribute DataSourceAttribute) (Dat
 }
 
 func (a *DataSourceBoolAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
@@ -20,6 +20,7 @@ type DataSourceAttribute interface {
 
 type DataSourceNestedAttribute interface {
 	ApplyNestedOverride([]string, explorer.Override) (DataSourceAttribute, error)
+	ApplyNestedReplace([]string, DataSourceAttribute) DataSourceAttribute
 }
 
 type DataSourceAttributes []DataSourceAttribute
@@ -34,14 +35,10 @@ func (targetSlice DataSourceAttributes) Merge(mergeSlices ...DataSourceAttribute
 
 			for i, targetAttribute := range targetSlice {
 				if targetAttribute.GetName() == mergeAttribute.GetName() {
+					// If the attribute types conflict, the target attribute is kept and a MergeConflictError is returned
 					mergedAttribute, err := targetAttribute.Merge(mergeAttribute)
-					if err != nil {
-						// TODO: consider how best to surface this error
-						// Currently, if the merge fails we should just keep the original target attribute for now
-						errResult = errors.Join(errResult, err)
-					} else {
-						targetSlice[i] = mergedAttribute
-					}
+					errResult = errors.Join(errResult, err)
+					targetSlice[i] = mergedAttribute
 
 					isNewAttribute = false
 					break
@@ -58,6 +55,47 @@ func (targetSlice DataSourceAttributes) Merge(mergeSlices ...DataSourceAttribute
 	return targetSlice, errResult
 }
 
+// ResolveMergeConflicts will replace each conflicting attribute with the attribute that was discarded during the merge, preferring
+// the attribute from the later merged slice.
+func (attributes DataSourceAttributes) ResolveMergeConflicts(conflicts []*MergeConflictError) DataSourceAttributes {
+	for _, conflict := range conflicts {
+		if conflict.dataSourceMergeAttribute == nil {
+			continue
+		}
+
+		attributes = attributes.Replace(conflict.Path, conflict.dataSourceMergeAttribute)
+	}
+
+	return attributes
+}
+
+// Replace will replace the attribute at path, which is dot-separated for nested attributes, with replacement.
+func (attributes DataSourceAttributes) Replace(path []string, replacement DataSourceAttribute) DataSourceAttributes {
+	if len(path) == 0 {
+		return attributes
+	}
+
+	for i, attribute := range attributes {
+		if attribute.GetName() != path[0] {
+			continue
+		}
+
+		if len(path) == 1 {
+			attributes[i] = replacement
+			break
+		}
+
+		nestedAttribute, ok := attribute.(DataSourceNestedAttribute)
+		if ok {
+			attributes[i] = nestedAttribute.ApplyNestedReplace(path[1:], replacement)
+		}
+
+		break
+	}
+
+	return attributes
+}
+
 func (attributes DataSourceAttributes) ToSpec() []datasource.Attribute {
 	specAttributes := make([]datasource.Attribute, 0, len(attributes))
 	for _, attribute := range attributes {
@@ -89,14 +127,15 @@ func (attributes DataSourceAttributes) ApplyOverride(path []string, override exp
 			if len(path) > 1 {
 				nestedAttribute, ok := attribute.(DataSourceNestedAttribute)
 				if !ok {
-					// TODO: error? there is a nested override for an attribute that is not a nested type
-					break
+					// There is a nested override for an attribute that is not a nested type
+					return attributes, &OverrideNotFoundError{Path: path}
 				}
 
 				// The attribute we need to override is deeper nested, move up
 				nextPath := path[1:]
 
 				overriddenAttribute, err := nestedAttribute.ApplyNestedOverride(nextPath, override)
+				prependOverrideNotFoundPath(err, path[0])
 				errResult = errors.Join(errResult, err)
 
 				attributes[i] = overriddenAttribute
@@ -109,9 +148,9 @@ func (attributes DataSourceAttributes) ApplyOverride(path []string, override exp
 				attributes[i] = overriddenAttribute
 			}
 
-			break
+			return attributes, errResult
 		}
 	}
 
-	return attributes, errResult
+	return attributes, &OverrideNotFoundError{Path: path}
 }
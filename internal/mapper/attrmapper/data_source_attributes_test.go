@@ -4,6 +4,8 @@
 package attrmapper_test
 
 import (
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -208,7 +210,7 @@ func TestDataSourceAttributes_ApplyOverrides(t *testing.T) {
 		attributes         attrmapper.DataSourceAttributes
 		expectedAttributes attrmapper.DataSourceAttributes
 	}{
-		// TODO: this may eventually return an error, but for now just returns without modification
+		// Errors for overrides that don't match are tested in TestDataSourceAttributes_ApplyOverrides_NotFound
 		"no matching overrides": {
 			overrides: map[string]explorer.Override{
 				"": {
@@ -371,3 +373,88 @@ func TestDataSourceAttributes_ApplyOverrides(t *testing.T) {
 func pointer[T any](value T) *T {
 	return &value
 }
+
+func TestDataSourceAttributes_ApplyOverrides_NotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overrides        map[string]explorer.Override
+		expectedNotFound []string
+	}{
+		"all matching overrides": {
+			overrides: map[string]explorer.Override{
+				"string_attribute": {
+					Description: "new description",
+				},
+				"single_nested_attribute.string_attribute": {
+					Description: "new description",
+				},
+			},
+			expectedNotFound: []string{},
+		},
+		"no matching overrides": {
+			overrides: map[string]explorer.Override{
+				"attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+				"single_nested_attribute.attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+				"string_attribute.attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+				"single_nested_attribute.string_attribute.attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+			},
+			expectedNotFound: []string{
+				"attribute_that_doesnt_exist",
+				"single_nested_attribute.attribute_that_doesnt_exist",
+				"single_nested_attribute.string_attribute.attribute_that_doesnt_exist",
+				"string_attribute.attribute_that_doesnt_exist",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "single_nested_attribute",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceStringAttribute{
+							Name: "string_attribute",
+							StringAttribute: datasource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			}
+
+			_, err := attributes.ApplyOverrides(testCase.overrides)
+
+			notFound := []string{}
+			for _, notFoundErr := range attrmapper.OverridesNotFound(err) {
+				notFound = append(notFound, strings.Join(notFoundErr.Path, "."))
+			}
+			sort.Strings(notFound)
+
+			if diff := cmp.Diff(notFound, testCase.expectedNotFound); diff != "" {
+				t.Errorf("Unexpected overrides not found (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
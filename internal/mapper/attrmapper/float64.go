@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceFloat64Attribute struct {
@@ -23,20 +25,38 @@ func (a *ResourceFloat64Attribute) GetName() string {
 
 func (a *ResourceFloat64Attribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	float64Attribute, ok := mergeAttribute.(*ResourceFloat64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newResourceMergeConflict(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = float64Attribute.Description
 	}
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, float64Attribute.ComputedOptionalRequired)
 
 	return a, nil
 }
 
 func (a *ResourceFloat64Attribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceFloat64Attribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.Float64PlanModifier{
+		Custom: frameworkplanmodifiers.Float64PlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
 func (a *ResourceFloat64Attribute) ToSpec() resource.Attribute {
 	return resource.Attribute{
 		Name:    util.TerraformIdentifier(a.Name),
@@ -56,8 +76,11 @@ func (a *DataSourceFloat64Attribute) GetName() string {
 
 func (a *DataSourceFloat64Attribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	float64Attribute, ok := mergeAttribute.(*DataSourceFloat64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = float64Attribute.Description
 	}
 
@@ -65,7 +88,13 @@ func (a *DataSourceFloat64Attribute) Merge(mergeAttribute DataSourceAttribute) (
 }
 
 func (a *DataSourceFloat64Attribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceInt64Attribute struct {
@@ -23,20 +25,38 @@ func (a *ResourceInt64Attribute) GetName() string {
 
 func (a *ResourceInt64Attribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	int64Attribute, ok := mergeAttribute.(*ResourceInt64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newResourceMergeConflict(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = int64Attribute.Description
 	}
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, int64Attribute.ComputedOptionalRequired)
 
 	return a, nil
 }
 
 func (a *ResourceInt64Attribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceInt64Attribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.Int64PlanModifier{
+		Custom: frameworkplanmodifiers.Int64PlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
 func (a *ResourceInt64Attribute) ToSpec() resource.Attribute {
 	return resource.Attribute{
 		Name:  util.TerraformIdentifier(a.Name),
@@ -56,8 +76,11 @@ func (a *DataSourceInt64Attribute) GetName() string {
 
 func (a *DataSourceInt64Attribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	int64Attribute, ok := mergeAttribute.(*DataSourceInt64Attribute)
-	// TODO: return error if types don't match?
-	if ok && (a.Description == nil || *a.Description == "") {
+	if !ok {
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
+	}
+
+	if a.Description == nil || *a.Description == "" {
 		a.Description = int64Attribute.Description
 	}
 
@@ -65,7 +88,13 @@ func (a *DataSourceInt64Attribute) Merge(mergeAttribute DataSourceAttribute) (Da
 }
 
 func (a *DataSourceInt64Attribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceListAttribute struct {
@@ -23,25 +25,39 @@ func (a *ResourceListAttribute) GetName() string {
 
 func (a *ResourceListAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	listAttribute, ok := mergeAttribute.(*ResourceListAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newResourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = listAttribute.Description
 	}
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, listAttribute.ComputedOptionalRequired)
 	a.ElementType = mergeElementType(a.ElementType, listAttribute.ElementType)
 
 	return a, nil
 }
 
 func (a *ResourceListAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceListAttribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.ListPlanModifier{
+		Custom: frameworkplanmodifiers.ListPlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
 func (a *ResourceListAttribute) ToSpec() resource.Attribute {
 	return resource.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -61,9 +77,8 @@ func (a *DataSourceListAttribute) GetName() string {
 
 func (a *DataSourceListAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	listAttribute, ok := mergeAttribute.(*DataSourceListAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -75,7 +90,13 @@ func (a *DataSourceListAttribute) Merge(mergeAttribute DataSourceAttribute) (Dat
 }
 
 func (a *DataSourceListAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceListNestedAttribute struct {
@@ -24,25 +26,57 @@ func (a *ResourceListNestedAttribute) GetName() string {
 
 func (a *ResourceListNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	listNestedAttribute, ok := mergeAttribute.(*ResourceListNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newResourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = listNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, listNestedAttribute.ComputedOptionalRequired)
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *ResourceListNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceListNestedAttribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.ListPlanModifier{
+		Custom: frameworkplanmodifiers.ListPlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
+func (a *ResourceListNestedAttribute) ApplyNestedRequiresReplace(updateAttribute ResourceAttribute) ResourceAttribute {
+	listNestedAttribute, ok := updateAttribute.(*ResourceListNestedAttribute)
+	if !ok {
+		return a
+	}
+
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ApplyRequiresReplace(listNestedAttribute.NestedObject.Attributes)
+
+	return a
+}
+
+func (a *ResourceListNestedAttribute) ApplyNestedReplace(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *ResourceListNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (ResourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -74,25 +108,37 @@ func (a *DataSourceListNestedAttribute) GetName() string {
 
 func (a *DataSourceListNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	listNestedAttribute, ok := mergeAttribute.(*DataSourceListNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = listNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(listNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *DataSourceListNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *DataSourceListNestedAttribute) ApplyNestedReplace(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceListNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (DataSourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceMapAttribute struct {
@@ -23,25 +25,39 @@ func (a *ResourceMapAttribute) GetName() string {
 
 func (a *ResourceMapAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	mapAttribute, ok := mergeAttribute.(*ResourceMapAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newResourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = mapAttribute.Description
 	}
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, mapAttribute.ComputedOptionalRequired)
 	a.ElementType = mergeElementType(a.ElementType, mapAttribute.ElementType)
 
 	return a, nil
 }
 
 func (a *ResourceMapAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceMapAttribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.MapPlanModifier{
+		Custom: frameworkplanmodifiers.MapPlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
 func (a *ResourceMapAttribute) ToSpec() resource.Attribute {
 	return resource.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -61,9 +77,8 @@ func (a *DataSourceMapAttribute) GetName() string {
 
 func (a *DataSourceMapAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	mapAttribute, ok := mergeAttribute.(*DataSourceMapAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
@@ -75,7 +90,13 @@ func (a *DataSourceMapAttribute) Merge(mergeAttribute DataSourceAttribute) (Data
 }
 
 func (a *DataSourceMapAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
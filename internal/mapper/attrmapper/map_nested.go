@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceMapNestedAttribute struct {
@@ -24,25 +26,57 @@ func (a *ResourceMapNestedAttribute) GetName() string {
 
 func (a *ResourceMapNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	mapNestedAttribute, ok := mergeAttribute.(*ResourceMapNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newResourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = mapNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, mapNestedAttribute.ComputedOptionalRequired)
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *ResourceMapNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceMapNestedAttribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.MapPlanModifier{
+		Custom: frameworkplanmodifiers.MapPlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
+func (a *ResourceMapNestedAttribute) ApplyNestedRequiresReplace(updateAttribute ResourceAttribute) ResourceAttribute {
+	mapNestedAttribute, ok := updateAttribute.(*ResourceMapNestedAttribute)
+	if !ok {
+		return a
+	}
+
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ApplyRequiresReplace(mapNestedAttribute.NestedObject.Attributes)
+
+	return a
+}
+
+func (a *ResourceMapNestedAttribute) ApplyNestedReplace(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *ResourceMapNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (ResourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -74,25 +108,37 @@ func (a *DataSourceMapNestedAttribute) GetName() string {
 
 func (a *DataSourceMapNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	mapNestedAttribute, ok := mergeAttribute.(*DataSourceMapNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = mapNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(mapNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *DataSourceMapNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *DataSourceMapNestedAttribute) ApplyNestedReplace(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceMapNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (DataSourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
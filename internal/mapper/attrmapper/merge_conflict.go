@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MergeConflictError is returned when two attributes with the same name can't be merged because they have different types, like
+// an `id` that is a string in a request body and an integer in a response body. The target attribute is kept when a merge conflict
+// occurs, unless the conflict is resolved with ResourceAttributes.ResolveMergeConflicts or DataSourceAttributes.ResolveMergeConflicts.
+type MergeConflictError struct {
+	// Path is the absolute reference to the conflicting attribute, including the names of any parent nested attributes.
+	Path []string
+
+	// TargetType is the type of the attribute that was kept.
+	TargetType string
+
+	// MergeType is the type of the attribute that was discarded.
+	MergeType string
+
+	// resourceMergeAttribute and dataSourceMergeAttribute hold the discarded attribute, used when a conflict is resolved in favor of
+	// the merge attribute.
+	resourceMergeAttribute   ResourceAttribute
+	dataSourceMergeAttribute DataSourceAttribute
+}
+
+// Error implements the error interface.
+func (e *MergeConflictError) Error() string {
+	return fmt.Sprintf("attribute %q has conflicting types: %s and %s", strings.Join(e.Path, "."), e.TargetType, e.MergeType)
+}
+
+// MergeConflicts returns all of the MergeConflictErrors in err, which can be a joined error returned from a Merge function.
+func MergeConflicts(err error) []*MergeConflictError {
+	if err == nil {
+		return nil
+	}
+
+	if joinedErr, ok := err.(interface{ Unwrap() []error }); ok {
+		conflicts := make([]*MergeConflictError, 0)
+		for _, e := range joinedErr.Unwrap() {
+			conflicts = append(conflicts, MergeConflicts(e)...)
+		}
+
+		return conflicts
+	}
+
+	var conflict *MergeConflictError
+	if errors.As(err, &conflict) {
+		return []*MergeConflictError{conflict}
+	}
+
+	return nil
+}
+
+// newResourceMergeConflict returns a MergeConflictError for a resource attribute that couldn't be merged.
+func newResourceMergeConflict(targetAttribute ResourceAttribute, mergeAttribute ResourceAttribute) *MergeConflictError {
+	return &MergeConflictError{
+		Path:                   []string{targetAttribute.GetName()},
+		TargetType:             attributeType(targetAttribute),
+		MergeType:              attributeType(mergeAttribute),
+		resourceMergeAttribute: mergeAttribute,
+	}
+}
+
+// newDataSourceMergeConflict returns a MergeConflictError for a data source attribute that couldn't be merged.
+func newDataSourceMergeConflict(targetAttribute DataSourceAttribute, mergeAttribute DataSourceAttribute) *MergeConflictError {
+	return &MergeConflictError{
+		Path:                     []string{targetAttribute.GetName()},
+		TargetType:               attributeType(targetAttribute),
+		MergeType:                attributeType(mergeAttribute),
+		dataSourceMergeAttribute: mergeAttribute,
+	}
+}
+
+// nestMergeConflicts adds the parent attribute name to the path of all MergeConflictErrors in err, which is returned from
+// merging the nested attributes of the parent.
+func nestMergeConflicts(parentName string, err error) error {
+	for _, conflict := range MergeConflicts(err) {
+		conflict.Path = append([]string{parentName}, conflict.Path...)
+	}
+
+	return err
+}
+
+// attributeType returns the name of the attribute type, used for describing merge conflicts.
+func attributeType(attribute any) string {
+	switch attribute.(type) {
+	case *ResourceBoolAttribute, *DataSourceBoolAttribute:
+		return "bool"
+	case *ResourceFloat64Attribute, *DataSourceFloat64Attribute:
+		return "float64"
+	case *ResourceInt64Attribute, *DataSourceInt64Attribute:
+		return "int64"
+	case *ResourceNumberAttribute, *DataSourceNumberAttribute:
+		return "number"
+	case *ResourceStringAttribute, *DataSourceStringAttribute:
+		return "string"
+	case *ResourceListAttribute, *DataSourceListAttribute:
+		return "list"
+	case *ResourceListNestedAttribute, *DataSourceListNestedAttribute:
+		return "list_nested"
+	case *ResourceMapAttribute, *DataSourceMapAttribute:
+		return "map"
+	case *ResourceMapNestedAttribute, *DataSourceMapNestedAttribute:
+		return "map_nested"
+	case *ResourceSetAttribute, *DataSourceSetAttribute:
+		return "set"
+	case *ResourceSetNestedAttribute, *DataSourceSetNestedAttribute:
+		return "set_nested"
+	case *ResourceSingleNestedAttribute, *DataSourceSingleNestedAttribute:
+		return "single_nested"
+	default:
+		return fmt.Sprintf("%T", attribute)
+	}
+}
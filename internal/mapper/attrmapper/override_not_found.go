@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OverrideNotFoundError is returned when an override doesn't match any attribute, like an override with a typo in the attribute
+// path, or a nested override for an attribute that isn't a nested attribute.
+type OverrideNotFoundError struct {
+	// Path is the absolute reference to the attribute of the override, including the names of any parent nested attributes.
+	Path []string
+}
+
+// Error implements the error interface.
+func (e *OverrideNotFoundError) Error() string {
+	return fmt.Sprintf("override %q doesn't match any attribute", strings.Join(e.Path, "."))
+}
+
+// prependOverrideNotFoundPath adds the name of a parent nested attribute to the path of an OverrideNotFoundError in err, if any.
+func prependOverrideNotFoundPath(err error, parentName string) {
+	var notFound *OverrideNotFoundError
+	if errors.As(err, &notFound) {
+		notFound.Path = append([]string{parentName}, notFound.Path...)
+	}
+}
+
+// OverridesNotFound returns all of the OverrideNotFoundErrors in err, which can be a joined error returned from an ApplyOverrides
+// function.
+func OverridesNotFound(err error) []*OverrideNotFoundError {
+	if err == nil {
+		return nil
+	}
+
+	if joinedErr, ok := err.(interface{ Unwrap() []error }); ok {
+		notFound := make([]*OverrideNotFoundError, 0)
+		for _, e := range joinedErr.Unwrap() {
+			notFound = append(notFound, OverridesNotFound(e)...)
+		}
+
+		return notFound
+	}
+
+	var notFound *OverrideNotFoundError
+	if errors.As(err, &notFound) {
+		return []*OverrideNotFoundError{notFound}
+	}
+
+	return nil
+}
@@ -9,17 +9,21 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceAttribute interface {
 	GetName() string
 	Merge(ResourceAttribute) (ResourceAttribute, error)
 	ApplyOverride(explorer.Override) (ResourceAttribute, error)
+	ApplyRequiresReplace() ResourceAttribute
 	ToSpec() resource.Attribute
 }
 
 type ResourceNestedAttribute interface {
 	ApplyNestedOverride([]string, explorer.Override) (ResourceAttribute, error)
+	ApplyNestedRequiresReplace(ResourceAttribute) ResourceAttribute
+	ApplyNestedReplace([]string, ResourceAttribute) ResourceAttribute
 }
 
 type ResourceAttributes []ResourceAttribute
@@ -34,14 +38,10 @@ func (targetSlice ResourceAttributes) Merge(mergeSlices ...ResourceAttributes) (
 
 			for i, targetAttribute := range targetSlice {
 				if targetAttribute.GetName() == mergeAttribute.GetName() {
+					// If the attribute types conflict, the target attribute is kept and a MergeConflictError is returned
 					mergedAttribute, err := targetAttribute.Merge(mergeAttribute)
-					if err != nil {
-						// TODO: consider how best to surface this error
-						// Currently, if the merge fails we should just keep the original target attribute for now
-						errResult = errors.Join(errResult, err)
-					} else {
-						targetSlice[i] = mergedAttribute
-					}
+					errResult = errors.Join(errResult, err)
+					targetSlice[i] = mergedAttribute
 
 					isNewAttribute = false
 					break
@@ -58,6 +58,111 @@ func (targetSlice ResourceAttributes) Merge(mergeSlices ...ResourceAttributes) (
 	return targetSlice, errResult
 }
 
+// ResolveMergeConflicts will replace each conflicting attribute with the attribute that was discarded during the merge, preferring
+// the attribute from the later merged slice.
+func (attributes ResourceAttributes) ResolveMergeConflicts(conflicts []*MergeConflictError) ResourceAttributes {
+	for _, conflict := range conflicts {
+		if conflict.resourceMergeAttribute == nil {
+			continue
+		}
+
+		attributes = attributes.Replace(conflict.Path, conflict.resourceMergeAttribute)
+	}
+
+	return attributes
+}
+
+// Replace will replace the attribute at path, which is dot-separated for nested attributes, with replacement.
+func (attributes ResourceAttributes) Replace(path []string, replacement ResourceAttribute) ResourceAttributes {
+	if len(path) == 0 {
+		return attributes
+	}
+
+	for i, attribute := range attributes {
+		if attribute.GetName() != path[0] {
+			continue
+		}
+
+		if len(path) == 1 {
+			attributes[i] = replacement
+			break
+		}
+
+		nestedAttribute, ok := attribute.(ResourceNestedAttribute)
+		if ok {
+			attributes[i] = nestedAttribute.ApplyNestedReplace(path[1:], replacement)
+		}
+
+		break
+	}
+
+	return attributes
+}
+
+// ApplyRequiresReplace will add a RequiresReplace plan modifier to all attributes that don't exist in updateAttributes, as they
+// can't be modified in-place by an update operation. Nested attributes that exist in both will be compared recursively. If
+// updateAttributes is nil, like when there is no update operation, all attributes will require replacement. Computed attributes
+// can't be configured, so they never require replacement.
+func (attributes ResourceAttributes) ApplyRequiresReplace(updateAttributes ResourceAttributes) ResourceAttributes {
+	for i, attribute := range attributes {
+		if resourceComputability(attribute) == schema.Computed {
+			continue
+		}
+
+		var updateAttribute ResourceAttribute
+		for _, candidate := range updateAttributes {
+			if candidate.GetName() == attribute.GetName() {
+				updateAttribute = candidate
+				break
+			}
+		}
+
+		if updateAttribute == nil {
+			attributes[i] = attribute.ApplyRequiresReplace()
+			continue
+		}
+
+		nestedAttribute, ok := attribute.(ResourceNestedAttribute)
+		if ok {
+			attributes[i] = nestedAttribute.ApplyNestedRequiresReplace(updateAttribute)
+		}
+	}
+
+	return attributes
+}
+
+// resourceComputability returns the computability of a resource attribute.
+func resourceComputability(attribute ResourceAttribute) schema.ComputedOptionalRequired {
+	switch a := attribute.(type) {
+	case *ResourceBoolAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceFloat64Attribute:
+		return a.ComputedOptionalRequired
+	case *ResourceInt64Attribute:
+		return a.ComputedOptionalRequired
+	case *ResourceNumberAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceStringAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceListAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceListNestedAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceMapAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceMapNestedAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceSetAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceSetNestedAttribute:
+		return a.ComputedOptionalRequired
+	case *ResourceSingleNestedAttribute:
+		return a.ComputedOptionalRequired
+	default:
+		return ""
+	}
+}
+
 func (attributes ResourceAttributes) ToSpec() []resource.Attribute {
 	specAttributes := make([]resource.Attribute, 0, len(attributes))
 	for _, attribute := range attributes {
@@ -89,14 +194,15 @@ func (attributes ResourceAttributes) ApplyOverride(path []string, override explo
 			if len(path) > 1 {
 				nestedAttribute, ok := attribute.(ResourceNestedAttribute)
 				if !ok {
-					// TODO: error? there is a nested override for an attribute that is not a nested type
-					break
+					// There is a nested override for an attribute that is not a nested type
+					return attributes, &OverrideNotFoundError{Path: path}
 				}
 
 				// The attribute we need to override is deeper nested, move up
 				nextPath := path[1:]
 
 				overriddenAttribute, err := nestedAttribute.ApplyNestedOverride(nextPath, override)
+				prependOverrideNotFoundPath(err, path[0])
 				errResult = errors.Join(errResult, err)
 
 				attributes[i] = overriddenAttribute
@@ -109,9 +215,9 @@ func (attributes ResourceAttributes) ApplyOverride(path []string, override explo
 				attributes[i] = overriddenAttribute
 			}
 
-			break
+			return attributes, errResult
 		}
 	}
 
-	return attributes, errResult
+	return attributes, &OverrideNotFoundError{Path: path}
 }
@@ -4,11 +4,14 @@
 package attrmapper_test
 
 import (
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
@@ -200,6 +203,204 @@ func TestResourceAttributes_Merge(t *testing.T) {
 	}
 }
 
+func TestResourceAttributes_MergeConflicts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		targetAttributes   attrmapper.ResourceAttributes
+		mergeAttributes    attrmapper.ResourceAttributes
+		expectedConflicts  []string
+		expectedAttributes attrmapper.ResourceAttributes
+		expectedResolved   attrmapper.ResourceAttributes
+	}{
+		"no conflicts": {
+			targetAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			mergeAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+			expectedConflicts: []string{},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedResolved: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+		"primitive conflict": {
+			targetAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			mergeAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "id",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+			expectedConflicts: []string{
+				`attribute "id" has conflicting types: string and int64`,
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedResolved: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "id",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+		"nested conflict": {
+			targetAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListNestedAttribute{
+					Name: "nested",
+					NestedObject: attrmapper.ResourceNestedAttributeObject{
+						Attributes: attrmapper.ResourceAttributes{
+							&attrmapper.ResourceSingleNestedAttribute{
+								Name:       "labels",
+								Attributes: attrmapper.ResourceAttributes{},
+								SingleNestedAttribute: resource.SingleNestedAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+					},
+					ListNestedAttribute: resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			mergeAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListNestedAttribute{
+					Name: "nested",
+					NestedObject: attrmapper.ResourceNestedAttributeObject{
+						Attributes: attrmapper.ResourceAttributes{
+							&attrmapper.ResourceMapAttribute{
+								Name: "labels",
+								MapAttribute: resource.MapAttribute{
+									ComputedOptionalRequired: schema.Computed,
+									ElementType: schema.ElementType{
+										String: &schema.StringType{},
+									},
+								},
+							},
+						},
+					},
+					ListNestedAttribute: resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+			expectedConflicts: []string{
+				`attribute "nested.labels" has conflicting types: single_nested and map`,
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListNestedAttribute{
+					Name: "nested",
+					NestedObject: attrmapper.ResourceNestedAttributeObject{
+						Attributes: attrmapper.ResourceAttributes{
+							&attrmapper.ResourceSingleNestedAttribute{
+								Name:       "labels",
+								Attributes: attrmapper.ResourceAttributes{},
+								SingleNestedAttribute: resource.SingleNestedAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+					},
+					ListNestedAttribute: resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			expectedResolved: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListNestedAttribute{
+					Name: "nested",
+					NestedObject: attrmapper.ResourceNestedAttributeObject{
+						Attributes: attrmapper.ResourceAttributes{
+							&attrmapper.ResourceMapAttribute{
+								Name: "labels",
+								MapAttribute: resource.MapAttribute{
+									ComputedOptionalRequired: schema.Computed,
+									ElementType: schema.ElementType{
+										String: &schema.StringType{},
+									},
+								},
+							},
+						},
+					},
+					ListNestedAttribute: resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.targetAttributes.Merge(testCase.mergeAttributes)
+
+			conflicts := attrmapper.MergeConflicts(err)
+			gotConflicts := make([]string, 0, len(conflicts))
+			for _, conflict := range conflicts {
+				gotConflicts = append(gotConflicts, conflict.Error())
+			}
+
+			if diff := cmp.Diff(gotConflicts, testCase.expectedConflicts); diff != "" {
+				t.Errorf("Unexpected conflicts (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedAttributes); diff != "" {
+				t.Errorf("Unexpected merged attributes (-got, +expected): %s", diff)
+			}
+
+			got = got.ResolveMergeConflicts(conflicts)
+
+			if diff := cmp.Diff(got, testCase.expectedResolved); diff != "" {
+				t.Errorf("Unexpected resolved attributes (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
 func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 	t.Parallel()
 
@@ -208,7 +409,7 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 		attributes         attrmapper.ResourceAttributes
 		expectedAttributes attrmapper.ResourceAttributes
 	}{
-		// TODO: this may eventually return an error, but for now just returns without modification
+		// Errors for overrides that don't match are tested in TestResourceAttributes_ApplyOverrides_NotFound
 		"no matching overrides": {
 			overrides: map[string]explorer.Override{
 				"": {
@@ -282,6 +483,65 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 				},
 			},
 		},
+		"matching computability overrides": {
+			overrides: map[string]explorer.Override{
+				"string_attribute": {
+					Computability: "computed_optional",
+				},
+				"single_nested.bool_attribute": {
+					Description:   "new description",
+					Computability: "computed",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Description:              pointer("old description"),
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "bool_attribute",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.Optional,
+								Description:              pointer("old description"),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("old description"),
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "bool_attribute",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.Computed,
+								Description:              pointer("new description"),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
 		"matching nested overrides": {
 			overrides: map[string]explorer.Override{
 				"single_nested": {
@@ -367,3 +627,272 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceAttributes_ApplyOverrides_NotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overrides        map[string]explorer.Override
+		expectedNotFound []string
+	}{
+		"all matching overrides": {
+			overrides: map[string]explorer.Override{
+				"string_attribute": {
+					Description: "new description",
+				},
+				"single_nested_attribute.string_attribute": {
+					Description: "new description",
+				},
+			},
+			expectedNotFound: []string{},
+		},
+		"no matching overrides": {
+			overrides: map[string]explorer.Override{
+				"attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+				"single_nested_attribute.attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+				"string_attribute.attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+				"single_nested_attribute.string_attribute.attribute_that_doesnt_exist": {
+					Description: "new description",
+				},
+			},
+			expectedNotFound: []string{
+				"attribute_that_doesnt_exist",
+				"single_nested_attribute.attribute_that_doesnt_exist",
+				"single_nested_attribute.string_attribute.attribute_that_doesnt_exist",
+				"string_attribute.attribute_that_doesnt_exist",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested_attribute",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "string_attribute",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			}
+
+			_, err := attributes.ApplyOverrides(testCase.overrides)
+
+			notFound := []string{}
+			for _, notFoundErr := range attrmapper.OverridesNotFound(err) {
+				notFound = append(notFound, strings.Join(notFoundErr.Path, "."))
+			}
+			sort.Strings(notFound)
+
+			if diff := cmp.Diff(notFound, testCase.expectedNotFound); diff != "" {
+				t.Errorf("Unexpected overrides not found (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceAttributes_ApplyRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes         attrmapper.ResourceAttributes
+		updateAttributes   attrmapper.ResourceAttributes
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"all attributes updatable": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			updateAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+		"attributes missing from update": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			updateAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"nested attributes missing from update": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListNestedAttribute{
+							Name: "list_nested",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{
+									&attrmapper.ResourceInt64Attribute{
+										Name: "int64_attribute",
+										Int64Attribute: resource.Int64Attribute{
+											ComputedOptionalRequired: schema.Required,
+										},
+									},
+								},
+							},
+							ListNestedAttribute: resource.ListNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			updateAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListNestedAttribute{
+							Name: "list_nested",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{},
+							},
+							ListNestedAttribute: resource.ListNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListNestedAttribute{
+							Name: "list_nested",
+							NestedObject: attrmapper.ResourceNestedAttributeObject{
+								Attributes: attrmapper.ResourceAttributes{
+									&attrmapper.ResourceInt64Attribute{
+										Name: "int64_attribute",
+										Int64Attribute: resource.Int64Attribute{
+											ComputedOptionalRequired: schema.Required,
+											PlanModifiers: schema.Int64PlanModifiers{
+												{
+													Custom: &schema.CustomPlanModifier{
+														Imports: []code.Import{
+															{
+																Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier",
+															},
+														},
+														SchemaDefinition: "int64planmodifier.RequiresReplace()",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							ListNestedAttribute: resource.ListNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attributes.ApplyRequiresReplace(testCase.updateAttributes)
+
+			if diff := cmp.Diff(got, testCase.expectedAttributes); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
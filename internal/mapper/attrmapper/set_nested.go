@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceSetNestedAttribute struct {
@@ -24,25 +26,57 @@ func (a *ResourceSetNestedAttribute) GetName() string {
 
 func (a *ResourceSetNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	setNestedAttribute, ok := mergeAttribute.(*ResourceSetNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newResourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = setNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, setNestedAttribute.ComputedOptionalRequired)
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *ResourceSetNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceSetNestedAttribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.SetPlanModifier{
+		Custom: frameworkplanmodifiers.SetPlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
+func (a *ResourceSetNestedAttribute) ApplyNestedRequiresReplace(updateAttribute ResourceAttribute) ResourceAttribute {
+	setNestedAttribute, ok := updateAttribute.(*ResourceSetNestedAttribute)
+	if !ok {
+		return a
+	}
+
+	a.NestedObject.Attributes = a.NestedObject.Attributes.ApplyRequiresReplace(setNestedAttribute.NestedObject.Attributes)
+
+	return a
+}
+
+func (a *ResourceSetNestedAttribute) ApplyNestedReplace(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *ResourceSetNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (ResourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -74,25 +108,37 @@ func (a *DataSourceSetNestedAttribute) GetName() string {
 
 func (a *DataSourceSetNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	setNestedAttribute, ok := mergeAttribute.(*DataSourceSetNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = setNestedAttribute.Description
 	}
-	a.NestedObject.Attributes, _ = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
+	var err error
+	a.NestedObject.Attributes, err = a.NestedObject.Attributes.Merge(setNestedAttribute.NestedObject.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *DataSourceSetNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *DataSourceSetNestedAttribute) ApplyNestedReplace(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.NestedObject.Attributes = a.NestedObject.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceSetNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (DataSourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
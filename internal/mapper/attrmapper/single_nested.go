@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceSingleNestedAttribute struct {
@@ -24,25 +26,57 @@ func (a *ResourceSingleNestedAttribute) GetName() string {
 
 func (a *ResourceSingleNestedAttribute) Merge(mergeAttribute ResourceAttribute) (ResourceAttribute, error) {
 	singleNestedAttribute, ok := mergeAttribute.(*ResourceSingleNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newResourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = singleNestedAttribute.Description
 	}
-	a.Attributes, _ = a.Attributes.Merge(singleNestedAttribute.Attributes)
+	a.ComputedOptionalRequired = mergeComputability(a.ComputedOptionalRequired, singleNestedAttribute.ComputedOptionalRequired)
+	var err error
+	a.Attributes, err = a.Attributes.Merge(singleNestedAttribute.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *ResourceSingleNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *ResourceSingleNestedAttribute) ApplyRequiresReplace() ResourceAttribute {
+	a.PlanModifiers = append(a.PlanModifiers, schema.ObjectPlanModifier{
+		Custom: frameworkplanmodifiers.ObjectPlanModifierRequiresReplace(),
+	})
+
+	return a
+}
+
+func (a *ResourceSingleNestedAttribute) ApplyNestedRequiresReplace(updateAttribute ResourceAttribute) ResourceAttribute {
+	singleNestedAttribute, ok := updateAttribute.(*ResourceSingleNestedAttribute)
+	if !ok {
+		return a
+	}
+
+	a.Attributes = a.Attributes.ApplyRequiresReplace(singleNestedAttribute.Attributes)
+
+	return a
+}
+
+func (a *ResourceSingleNestedAttribute) ApplyNestedReplace(path []string, replacement ResourceAttribute) ResourceAttribute {
+	a.Attributes = a.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *ResourceSingleNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (ResourceAttribute, error) {
 	var err error
 	a.Attributes, err = a.Attributes.ApplyOverride(path, override)
@@ -72,25 +106,37 @@ func (a *DataSourceSingleNestedAttribute) GetName() string {
 
 func (a *DataSourceSingleNestedAttribute) Merge(mergeAttribute DataSourceAttribute) (DataSourceAttribute, error) {
 	singleNestedAttribute, ok := mergeAttribute.(*DataSourceSingleNestedAttribute)
-	// TODO: return error if types don't match?
 	if !ok {
-		return a, nil
+		return a, newDataSourceMergeConflict(a, mergeAttribute)
 	}
 
 	if a.Description == nil || *a.Description == "" {
 		a.Description = singleNestedAttribute.Description
 	}
-	a.Attributes, _ = a.Attributes.Merge(singleNestedAttribute.Attributes)
+	var err error
+	a.Attributes, err = a.Attributes.Merge(singleNestedAttribute.Attributes)
 
-	return a, nil
+	return a, nestMergeConflicts(a.Name, err)
 }
 
 func (a *DataSourceSingleNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.Computability != "" {
+		a.ComputedOptionalRequired = schema.ComputedOptionalRequired(override.Computability)
+	}
 
 	return a, nil
 }
 
+func (a *DataSourceSingleNestedAttribute) ApplyNestedReplace(path []string, replacement DataSourceAttribute) DataSourceAttribute {
+	a.Attributes = a.Attributes.Replace(path, replacement)
+
+	return a
+}
+
 func (a *DataSourceSingleNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (DataSourceAttribute, error) {
 	var err error
 	a.Attributes, err = a.Attributes.ApplyOverride(path, override)
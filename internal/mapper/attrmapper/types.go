@@ -63,3 +63,14 @@ func mergeObjectAttributeTypes(targetAttrTypes []schema.ObjectAttributeType, mer
 
 	return targetAttrTypes
 }
+
+// mergeComputability returns ComputedOptional if an Optional attribute is merged with a Computed attribute, like an optional
+// request body property without a default value that is also returned in a response body. As the API may set the value when it's
+// not configured, the attribute can't be Optional. Otherwise, the target computability is kept.
+func mergeComputability(target schema.ComputedOptionalRequired, merge schema.ComputedOptionalRequired) schema.ComputedOptionalRequired {
+	if target == schema.Optional && merge == schema.Computed {
+		return schema.ComputedOptional
+	}
+
+	return target
+}
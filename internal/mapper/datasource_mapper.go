@@ -4,6 +4,7 @@
 package mapper
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -26,17 +27,20 @@ type DataSourceMapper interface {
 type dataSourceMapper struct {
 	dataSources map[string]explorer.DataSource
 	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg  config.Config
+	opts Options
 }
 
-func NewDataSourceMapper(dataSources map[string]explorer.DataSource, cfg config.Config) DataSourceMapper {
+func NewDataSourceMapper(dataSources map[string]explorer.DataSource, cfg config.Config, opts Options) DataSourceMapper {
 	return dataSourceMapper{
 		dataSources: dataSources,
 		cfg:         cfg,
+		opts:        opts,
 	}
 }
 
 func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]datasource.DataSource, error) {
+	var errResult error
 	dataSourceSchemas := []datasource.DataSource{}
 
 	// Guarantee the order of processing
@@ -45,9 +49,19 @@ func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]datasource.DataSource,
 		dataSource := m.dataSources[name]
 		dLogger := logger.With("data_source", name)
 
-		schema, err := generateDataSourceSchema(dLogger, name, dataSource)
+		schema, err := generateDataSourceSchema(dLogger, name, dataSource, m.opts)
 		if err != nil {
-			log.WarnLogOnError(dLogger, err, "skipping data source schema mapping")
+			if !m.opts.Strict {
+				log.WarnLogOnError(dLogger, err, "skipping data source schema mapping")
+				continue
+			}
+
+			// Unused options have already been logged individually, each with their own diagnostic code
+			var unusedErr *UnusedOptionError
+			if !errors.As(err, &unusedErr) {
+				log.ErrorLogOnError(dLogger, err, "skipping data source schema mapping")
+			}
+			errResult = errors.Join(errResult, &SkippedError{Kind: "data source", Name: name, Err: err})
 			continue
 		}
 
@@ -57,24 +71,53 @@ func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]datasource.DataSource,
 		})
 	}
 
-	return dataSourceSchemas, nil
+	return dataSourceSchemas, errResult
 }
 
-func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource) (*datasource.Schema, error) {
-	dataSourceSchema := &datasource.Schema{
-		Attributes: []datasource.Attribute{},
+func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource, opts Options) (*datasource.Schema, error) {
+	usage := newOptionUsage()
+	dataSourceAttributes, err := mapDataSourceAttributes(logger, name, dataSource, opts, usage)
+	if err != nil {
+		return nil, err
+	}
+
+	dataSourceAttributes, err = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
+
+	// Ignores, overrides, and aliases that didn't match anything are most likely typos, or properties renamed in the OpenAPI spec
+	err = logUnusedOptions(logger, usage.unusedOptions(dataSource.SchemaOptions, err), opts)
+	if err != nil {
+		return nil, err
 	}
 
+	return &datasource.Schema{
+		Attributes: dataSourceAttributes.ToSpec(),
+	}, nil
+}
+
+// mapDataSourceAttributes maps the response body and parameters of the read operation to attributes, then merges them together.
+// Overrides are not applied. The ignores, overrides, and aliases that match are recorded in usage, which can be nil.
+func mapDataSourceAttributes(logger *slog.Logger, name string, dataSource explorer.DataSource, opts Options, usage *optionUsage) (attrmapper.DataSourceAttributes, error) {
 	// ********************
 	// READ Response Body (required)
 	// ********************
 	logger.Debug("searching for read operation response body")
 
 	schemaOpts := oas.SchemaOpts{
-		Ignores: dataSource.SchemaOptions.Ignores,
+		Ignores:             dataSource.SchemaOptions.Ignores,
+		JSONStrings:         jsonStringOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides),
+		MultiTypeStrategies: multiTypeStrategyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides),
+		SchemaPath:          dataSource.ReadOpOptions.ResponsePath,
+		ResponseCode:        dataSource.ReadOpOptions.ResponseCode,
+		MediaType:           dataSource.ReadOpOptions.MediaType,
 	}
+	warnings := &oas.SchemaWarnings{}
 	globalSchemaOpts := oas.GlobalSchemaOpts{
 		OverrideComputability: schema.Computed,
+		RecursionOpts:         recursionOpts(dataSource.SchemaOptions),
+		MultiTypeStrategy:     dataSource.SchemaOptions.MultiTypeStrategy,
+		Warnings:              warnings,
+		BestEffort:            !opts.Strict,
+		Matches:               usage.optionMatches(),
 	}
 	readResponseSchema, err := oas.BuildSchemaFromResponse(dataSource.ReadOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
@@ -108,7 +151,7 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 	// ****************
 	readParameterAttributes := attrmapper.DataSourceAttributes{}
 	for _, param := range dataSource.ReadOpParameters() {
-		if param.In != util.OAS_param_path && param.In != util.OAS_param_query {
+		if !isParameterMapped(param, dataSource.SchemaOptions.ParameterOptions) {
 			continue
 		}
 
@@ -118,8 +161,17 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 			OverrideDescription: param.Description,
 		}
 
-		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, oas.GlobalSchemaOpts{})
+		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, oas.GlobalSchemaOpts{
+			RecursionOpts:     recursionOpts(dataSource.SchemaOptions),
+			MultiTypeStrategy: dataSource.SchemaOptions.MultiTypeStrategy,
+			Warnings:          warnings,
+			BestEffort:        !opts.Strict,
+			Matches:           usage.optionMatches(),
+		})
 		if schemaErr != nil {
+			if opts.Strict {
+				return nil, fmt.Errorf("error mapping read operation parameter %q: %w", param.Name, schemaErr)
+			}
 			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
 			continue
 		}
@@ -130,10 +182,10 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 		}
 
 		// Check for any aliases and replace the paramater name if found
-		paramName := param.Name
-		if aliasedName, ok := dataSource.SchemaOptions.AttributeOptions.Aliases[param.Name]; ok {
-			pLogger = pLogger.With("param_alias", aliasedName)
-			paramName = aliasedName
+		paramName, aliased := parameterAttributeName(param, dataSource.SchemaOptions.AttributeOptions.Aliases)
+		if aliased {
+			pLogger = pLogger.With("param_alias", paramName)
+			usage.addAlias(param, dataSource.SchemaOptions.AttributeOptions.Aliases)
 		}
 
 		if s.IsPropertyIgnored(paramName) {
@@ -142,6 +194,9 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 
 		parameterAttribute, schemaErr := s.BuildDataSourceAttribute(paramName, computability)
 		if schemaErr != nil {
+			if opts.Strict {
+				return nil, fmt.Errorf("error mapping read operation parameter %q: %w", param.Name, schemaErr)
+			}
 			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
 			continue
 		}
@@ -149,12 +204,15 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 		readParameterAttributes = append(readParameterAttributes, parameterAttribute)
 	}
 
-	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
-	dataSourceAttributes, _ := readParameterAttributes.Merge(readResponseAttributes)
+	dataSourceAttributes, err := mergeAttributes(logger, dataSource.SchemaOptions.MergeConflicts, []mergeSource[attrmapper.DataSourceAttributes]{
+		{name: "read operation parameters", attributes: readParameterAttributes, lookupLineNumber: parameterLineNumbers(dataSource.ReadOpParameters(), dataSource.SchemaOptions.AttributeOptions.Aliases)},
+		{name: "read operation response body", response: true, attributes: readResponseAttributes, lookupLineNumber: schemaLineNumbers(readResponseSchema)},
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO: handle error for overrides
-	dataSourceAttributes, _ = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
+	logSchemaWarnings(logger, warnings)
 
-	dataSourceSchema.Attributes = dataSourceAttributes.ToSpec()
-	return dataSourceSchema, nil
+	return dataSourceAttributes, nil
 }
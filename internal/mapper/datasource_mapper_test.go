@@ -4,6 +4,7 @@
 package mapper_test
 
 import (
+	"errors"
 	"log/slog"
 	"testing"
 
@@ -811,6 +812,146 @@ func TestDataSourceMapper_basic_merges(t *testing.T) {
 				},
 			},
 		},
+		"header and cookie parameters": {
+			readParams: []*high.Parameter{
+				{
+					Name:        "X-Org-Id",
+					Required:    pointer(true),
+					In:          "header",
+					Description: "hey this is a required header!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name:        "x-api-version",
+					In:          "header",
+					Description: "hey this is an aliased header!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name: "Authorization",
+					In:   "header",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name:        "session-id",
+					In:          "cookie",
+					Description: "hey this is a cookie!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			},
+			readResponseSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is a string!",
+					}),
+				}),
+			}),
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"X-API-Version": "version",
+					},
+				},
+				ParameterOptions: explorer.ParameterOptions{
+					Headers: true,
+					Cookies: true,
+				},
+			},
+			want: datasource.Attributes{
+				{
+					Name: "org_id",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a required header!"),
+					},
+				},
+				{
+					Name: "version",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is an aliased header!"),
+					},
+				},
+				{
+					Name: "session_id",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is a cookie!"),
+					},
+				},
+				{
+					Name: "string_prop",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("hey this is a string!"),
+					},
+				},
+			},
+		},
+		"header and cookie parameters not mapped by default": {
+			readParams: []*high.Parameter{
+				{
+					Name:        "X-Org-Id",
+					Required:    pointer(true),
+					In:          "header",
+					Description: "hey this is a required header!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name:        "x-api-version",
+					In:          "header",
+					Description: "hey this is an aliased header!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name: "Authorization",
+					In:   "header",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name:        "session-id",
+					In:          "cookie",
+					Description: "hey this is a cookie!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			},
+			readResponseSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is a string!",
+					}),
+				}),
+			}),
+			want: datasource.Attributes{
+				{
+					Name: "string_prop",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("hey this is a string!"),
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -822,7 +963,7 @@ func TestDataSourceMapper_basic_merges(t *testing.T) {
 					ReadOp:        createTestReadOp(testCase.readResponseSchema, testCase.readParams),
 					SchemaOptions: testCase.schemaOptions,
 				},
-			}, config.Config{})
+			}, config.Config{}, mapper.Options{})
 			got, err := mapper.MapToIR(slog.Default())
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -992,7 +1133,7 @@ func TestDataSourceMapper_collections(t *testing.T) {
 				"test_datasources": {
 					ReadOp: createTestReadOp(testCase.readResponseSchema, nil),
 				},
-			}, config.Config{})
+			}, config.Config{}, mapper.Options{})
 			got, err := mapper.MapToIR(slog.Default())
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -1008,3 +1149,116 @@ func TestDataSourceMapper_collections(t *testing.T) {
 		})
 	}
 }
+
+func TestDataSourceMapper_parameter_errors(t *testing.T) {
+	t.Parallel()
+
+	readParams := []*high.Parameter{
+		{
+			Name: "???",
+			In:   "query",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+		{
+			Name: "filter",
+			In:   "query",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"status": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					"!!!": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+		},
+	}
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	testCases := map[string]struct {
+		opts        mapper.Options
+		want        *datasource.Attributes
+		expectedErr string
+	}{
+		"default - unmappable parameter and nested parameter property are dropped": {
+			want: &datasource.Attributes{
+				{
+					Name: "filter",
+					SingleNested: &datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Attributes: datasource.Attributes{
+							{
+								Name: "status",
+								String: &datasource.StringAttribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "name",
+					String: &datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+		"strict - data source is skipped": {
+			opts:        mapper.Options{Strict: true},
+			want:        nil,
+			expectedErr: `skipped data source "test_datasource": error mapping read operation parameter "???": '???' cannot be converted to a valid Terraform identifier`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var skippedErr *mapper.SkippedError
+			mapper := mapper.NewDataSourceMapper(map[string]explorer.DataSource{
+				"test_datasource": {
+					ReadOp: createTestReadOp(readResponseSchema, readParams),
+				},
+			}, config.Config{}, testCase.opts)
+			got, err := mapper.MapToIR(slog.Default())
+			if testCase.expectedErr != "" {
+				if !errors.As(err, &skippedErr) {
+					t.Fatalf("expected skipped error, got: %v", err)
+				}
+				if err.Error() != testCase.expectedErr {
+					t.Errorf("expected error %q, got %q", testCase.expectedErr, err.Error())
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.want == nil {
+				if len(got) != 0 {
+					t.Fatalf("expected data source to be skipped, got: %d", len(got))
+				}
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one data source, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, *testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
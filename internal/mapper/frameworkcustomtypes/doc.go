@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package frameworkcustomtypes contains functionality for mapping custom
+// types onto specification that uses the terraform-plugin-framework custom
+// type modules, such as jsontypes.
+package frameworkcustomtypes
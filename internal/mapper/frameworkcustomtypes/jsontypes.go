@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// JSONTypesPackage is the name of the jsontypes package in the
+	// framework jsontypes module.
+	JSONTypesPackage = "jsontypes"
+
+	// JSONTypesCodeImportPath is the code import path for the framework
+	// jsontypes package.
+	JSONTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-jsontypes/" + JSONTypesPackage
+)
+
+// JSONTypesNormalized returns a custom type mapped to the jsontypes package
+// Normalized type, which is a string that holds semantically equal JSON.
+func JSONTypesNormalized() *schema.CustomType {
+	return &schema.CustomType{
+		Import: &code.Import{
+			Path: JSONTypesCodeImportPath,
+		},
+		Type:      JSONTypesPackage + ".NormalizedType{}",
+		ValueType: JSONTypesPackage + ".Normalized",
+	}
+}
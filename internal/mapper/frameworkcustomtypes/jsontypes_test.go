@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkcustomtypes_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestJSONTypesNormalized(t *testing.T) {
+	t.Parallel()
+
+	expected := &schema.CustomType{
+		Import: &code.Import{
+			Path: "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes",
+		},
+		Type:      "jsontypes.NormalizedType{}",
+		ValueType: "jsontypes.Normalized",
+	}
+
+	got := frameworkcustomtypes.JSONTypesNormalized()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// BoolPlanModifierPackage is the name of the bool plan modifier package in
+	// the framework resource schema.
+	BoolPlanModifierPackage = "boolplanmodifier"
+)
+
+var (
+	// BoolPlanModifierCodeImport is a single allocation of the framework
+	// resource schema boolplanmodifier package import.
+	BoolPlanModifierCodeImport code.Import = CodeImport(BoolPlanModifierPackage)
+)
+
+// BoolPlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// boolplanmodifier package RequiresReplace function.
+func BoolPlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(BoolPlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			BoolPlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkplanmodifiers"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+func TestBoolPlanModifierRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	expected := &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			{
+				Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier",
+			},
+		},
+		SchemaDefinition: "boolplanmodifier.RequiresReplace()",
+	}
+
+	got := frameworkplanmodifiers.BoolPlanModifierRequiresReplace()
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
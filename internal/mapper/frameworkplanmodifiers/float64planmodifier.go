@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// Float64PlanModifierPackage is the name of the float64 plan modifier package in
+	// the framework resource schema.
+	Float64PlanModifierPackage = "float64planmodifier"
+)
+
+var (
+	// Float64PlanModifierCodeImport is a single allocation of the framework
+	// resource schema float64planmodifier package import.
+	Float64PlanModifierCodeImport code.Import = CodeImport(Float64PlanModifierPackage)
+)
+
+// Float64PlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// float64planmodifier package RequiresReplace function.
+func Float64PlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(Float64PlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			Float64PlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
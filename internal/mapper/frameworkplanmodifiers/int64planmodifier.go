@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// Int64PlanModifierPackage is the name of the int64 plan modifier package in
+	// the framework resource schema.
+	Int64PlanModifierPackage = "int64planmodifier"
+)
+
+var (
+	// Int64PlanModifierCodeImport is a single allocation of the framework
+	// resource schema int64planmodifier package import.
+	Int64PlanModifierCodeImport code.Import = CodeImport(Int64PlanModifierPackage)
+)
+
+// Int64PlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// int64planmodifier package RequiresReplace function.
+func Int64PlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(Int64PlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			Int64PlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ListPlanModifierPackage is the name of the list plan modifier package in
+	// the framework resource schema.
+	ListPlanModifierPackage = "listplanmodifier"
+)
+
+var (
+	// ListPlanModifierCodeImport is a single allocation of the framework
+	// resource schema listplanmodifier package import.
+	ListPlanModifierCodeImport code.Import = CodeImport(ListPlanModifierPackage)
+)
+
+// ListPlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// listplanmodifier package RequiresReplace function.
+func ListPlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(ListPlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			ListPlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
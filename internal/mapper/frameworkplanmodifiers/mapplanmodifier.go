@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// MapPlanModifierPackage is the name of the map plan modifier package in
+	// the framework resource schema.
+	MapPlanModifierPackage = "mapplanmodifier"
+)
+
+var (
+	// MapPlanModifierCodeImport is a single allocation of the framework
+	// resource schema mapplanmodifier package import.
+	MapPlanModifierCodeImport code.Import = CodeImport(MapPlanModifierPackage)
+)
+
+// MapPlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// mapplanmodifier package RequiresReplace function.
+func MapPlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(MapPlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			MapPlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
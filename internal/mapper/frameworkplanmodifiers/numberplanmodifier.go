@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// NumberPlanModifierPackage is the name of the number plan modifier package in
+	// the framework resource schema.
+	NumberPlanModifierPackage = "numberplanmodifier"
+)
+
+var (
+	// NumberPlanModifierCodeImport is a single allocation of the framework
+	// resource schema numberplanmodifier package import.
+	NumberPlanModifierCodeImport code.Import = CodeImport(NumberPlanModifierPackage)
+)
+
+// NumberPlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// numberplanmodifier package RequiresReplace function.
+func NumberPlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(NumberPlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			NumberPlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
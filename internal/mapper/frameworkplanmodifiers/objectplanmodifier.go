@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ObjectPlanModifierPackage is the name of the object plan modifier package in
+	// the framework resource schema.
+	ObjectPlanModifierPackage = "objectplanmodifier"
+)
+
+var (
+	// ObjectPlanModifierCodeImport is a single allocation of the framework
+	// resource schema objectplanmodifier package import.
+	ObjectPlanModifierCodeImport code.Import = CodeImport(ObjectPlanModifierPackage)
+)
+
+// ObjectPlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// objectplanmodifier package RequiresReplace function.
+func ObjectPlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(ObjectPlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			ObjectPlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
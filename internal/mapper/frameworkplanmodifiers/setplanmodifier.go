@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// SetPlanModifierPackage is the name of the set plan modifier package in
+	// the framework resource schema.
+	SetPlanModifierPackage = "setplanmodifier"
+)
+
+var (
+	// SetPlanModifierCodeImport is a single allocation of the framework
+	// resource schema setplanmodifier package import.
+	SetPlanModifierCodeImport code.Import = CodeImport(SetPlanModifierPackage)
+)
+
+// SetPlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// setplanmodifier package RequiresReplace function.
+func SetPlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(SetPlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			SetPlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkplanmodifiers
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// StringPlanModifierPackage is the name of the string plan modifier package in
+	// the framework resource schema.
+	StringPlanModifierPackage = "stringplanmodifier"
+)
+
+var (
+	// StringPlanModifierCodeImport is a single allocation of the framework
+	// resource schema stringplanmodifier package import.
+	StringPlanModifierCodeImport code.Import = CodeImport(StringPlanModifierPackage)
+)
+
+// StringPlanModifierRequiresReplace returns a custom plan modifier mapped to the
+// stringplanmodifier package RequiresReplace function.
+func StringPlanModifierRequiresReplace() *schema.CustomPlanModifier {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(StringPlanModifierPackage)
+	schemaDefinition.WriteString(".RequiresReplace()")
+
+	return &schema.CustomPlanModifier{
+		Imports: []code.Import{
+			StringPlanModifierCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
@@ -16,3 +16,9 @@ func CodeImport(packagePath string) code.Import {
 		Path: CodeImportBasePath + "/" + packagePath,
 	}
 }
+
+// PathCodeImport is a single allocation of the framework path package import,
+// which is required for validators that accept path expressions.
+var PathCodeImport code.Import = code.Import{
+	Path: "github.com/hashicorp/terraform-plugin-framework/path",
+}
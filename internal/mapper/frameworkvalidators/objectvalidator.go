@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ObjectValidatorPackage is the name of the object validation package in
+	// the framework validators module.
+	ObjectValidatorPackage = "objectvalidator"
+)
+
+var (
+	// ObjectValidatorCodeImport is a single allocation of the framework
+	// validators module objectvalidator package import.
+	ObjectValidatorCodeImport code.Import = CodeImport(ObjectValidatorPackage)
+)
+
+// ObjectValidatorExactlyOneOf returns a custom validator mapped to the
+// objectvalidator package ExactlyOneOf function. The attribute names are
+// mapped to path expressions relative to the parent of the attribute the
+// validator is applied to. If the attribute names are nil or empty, nil
+// is returned.
+func ObjectValidatorExactlyOneOf(attributeNames []string) *schema.CustomValidator {
+	if len(attributeNames) == 0 {
+		return nil
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(ObjectValidatorPackage)
+	schemaDefinition.WriteString(".ExactlyOneOf(\n")
+
+	for _, attributeName := range attributeNames {
+		schemaDefinition.WriteString("path.MatchRelative().AtParent().AtName(" + strconv.Quote(attributeName) + "),\n")
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			ObjectValidatorCodeImport,
+			PathCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
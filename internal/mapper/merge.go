@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+const (
+	mergeConflictsPreferResponse = "prefer_response"
+	mergeConflictsFail           = "fail"
+)
+
+// mergeableAttributes is implemented by attrmapper.ResourceAttributes and attrmapper.DataSourceAttributes.
+type mergeableAttributes[T any] interface {
+	Merge(...T) (T, error)
+	ResolveMergeConflicts([]*attrmapper.MergeConflictError) T
+}
+
+// mergeSource contains attributes that were mapped from one part of an operation, like a request body or parameters, and
+// information used to report where conflicting attributes were found.
+type mergeSource[T mergeableAttributes[T]] struct {
+	// name describes where the attributes were mapped from, like "create operation request body"
+	name string
+
+	// response is true if the attributes were mapped from a response body
+	response bool
+
+	attributes T
+
+	// lookupLineNumber returns the line number of the attribute at path, or false if the attribute doesn't exist in this source
+	lookupLineNumber func(path []string) (int, bool)
+}
+
+// mergeAttributes merges the attributes of all sources in order, reporting any attributes that have conflicting types. By default,
+// the attribute from the earliest source is kept, the "prefer_response" policy will keep the attribute from a response body instead,
+// and the "fail" policy will return an error with all conflicts found.
+func mergeAttributes[T mergeableAttributes[T]](logger *slog.Logger, policy string, sources []mergeSource[T]) (T, error) {
+	var errResult error
+
+	mergedAttributes := sources[0].attributes
+	for i, source := range sources[1:] {
+		var err error
+		mergedAttributes, err = mergedAttributes.Merge(source.attributes)
+
+		conflicts := attrmapper.MergeConflicts(err)
+		if len(conflicts) == 0 {
+			continue
+		}
+
+		preferMerged := policy == mergeConflictsPreferResponse && source.response
+		if preferMerged {
+			mergedAttributes = mergedAttributes.ResolveMergeConflicts(conflicts)
+		}
+
+		for _, conflict := range conflicts {
+			conflictErr := describeMergeConflict(conflict, sources[:i+1], source)
+			if policy == mergeConflictsFail {
+				errResult = errors.Join(errResult, conflictErr)
+				continue
+			}
+
+			keptType := conflict.TargetType
+			if preferMerged {
+				keptType = conflict.MergeType
+			}
+
+			cLogger := logger.With("code", diagnostic.CodeMergeConflict, "oas_path", strings.Join(conflict.Path, "."))
+			if source.lookupLineNumber != nil {
+				if lineNumber, ok := source.lookupLineNumber(conflict.Path); ok && lineNumber != 0 {
+					cLogger = cLogger.With("oas_line_number", lineNumber)
+				}
+			}
+
+			cLogger.Warn("merging attributes with conflicting types", "kept_type", keptType, "err", conflictErr)
+		}
+	}
+
+	return mergedAttributes, errResult
+}
+
+// describeMergeConflict returns an error describing which sources the conflicting attribute types were found in, with line numbers.
+func describeMergeConflict[T mergeableAttributes[T]](conflict *attrmapper.MergeConflictError, previousSources []mergeSource[T], conflictSource mergeSource[T]) error {
+	targetLocation := "previously merged attributes"
+	for _, source := range previousSources {
+		if location, ok := sourceLocation(source, conflict.Path); ok {
+			targetLocation = location
+			break
+		}
+	}
+
+	conflictLocation, _ := sourceLocation(conflictSource, conflict.Path)
+
+	return fmt.Errorf("%w - %s in %s, %s in %s", conflict, conflict.TargetType, targetLocation, conflict.MergeType, conflictLocation)
+}
+
+// sourceLocation returns the name of the source, with a line number if available, if the attribute at path exists in the source.
+func sourceLocation[T mergeableAttributes[T]](source mergeSource[T], path []string) (string, bool) {
+	if source.lookupLineNumber == nil {
+		return source.name, false
+	}
+
+	lineNumber, ok := source.lookupLineNumber(path)
+	if !ok {
+		return source.name, false
+	}
+
+	if lineNumber == 0 {
+		return source.name, true
+	}
+
+	return fmt.Sprintf("%s (line %d)", source.name, lineNumber), true
+}
+
+// schemaLineNumbers returns a function that looks up property line numbers in an OAS schema, which can be nil.
+func schemaLineNumbers(s *oas.OASSchema) func([]string) (int, bool) {
+	return func(path []string) (int, bool) {
+		if s == nil {
+			return 0, false
+		}
+
+		return s.LookupPropertyLineNumber(path)
+	}
+}
+
+// parameterLineNumbers returns a function that looks up the line numbers of mapped parameters, using the aliased attribute name.
+func parameterLineNumbers(params []*high.Parameter, aliases map[string]string) func([]string) (int, bool) {
+	return func(path []string) (int, bool) {
+		for _, param := range params {
+			paramName, _ := parameterAttributeName(param, aliases)
+			if paramName != path[0] {
+				continue
+			}
+
+			if len(path) > 1 || param.GoLow() == nil {
+				return 0, len(path) == 1
+			}
+
+			return param.GoLow().Name.NodeLineNumber(), true
+		}
+
+		return 0, false
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// mergeAllOf will combine all allOf subschemas of a schema, along with the keywords defined on the schema itself, into one schema.
+//   - type: The types must be compatible, i.e. "integer" and "number" will be merged to "integer". Contradictory types will return a SchemaError.
+//   - properties: All properties will be combined. Properties defined in multiple subschemas will have their schemas merged with allOf.
+//   - required: All required properties will be combined.
+//   - description: The description of the parent schema will be used if populated, otherwise all unique subschema descriptions are combined.
+//   - validation keywords: The most restrictive minimums and maximums will be used, enums will be intersected, and boolean keywords
+//     like "readOnly" or "uniqueItems" will be enabled if any of the subschemas enable them.
+//   - All other keywords (format, pattern, default, etc.) will use the first value found, starting with the parent schema and then each subschema in order.
+func mergeAllOf(s *base.Schema) (*base.Schema, *SchemaError) {
+	merged := *s
+	merged.AllOf = nil
+	merged.Type = slices.Clone(s.Type)
+	merged.Required = slices.Clone(s.Required)
+	merged.Properties = orderedmap.New[string, *base.SchemaProxy]()
+	if s.Properties != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), s.Properties) {
+			merged.Properties.Set(pair.Key(), pair.Value())
+		}
+	}
+
+	descriptions := []string{}
+	for _, proxy := range s.AllOf {
+		subschema, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		err = mergeAllOfSubschema(&merged, subschema)
+		if err != nil {
+			return nil, err
+		}
+
+		if subschema.Description != "" && !slices.Contains(descriptions, subschema.Description) {
+			descriptions = append(descriptions, subschema.Description)
+		}
+	}
+
+	// Override the description w/ the parent if populated
+	if merged.Description == "" {
+		merged.Description = strings.Join(descriptions, "\n\n")
+	}
+
+	if merged.Properties.Len() == 0 {
+		merged.Properties = nil
+	}
+
+	return &merged, nil
+}
+
+// mergeAllOfSubschema will merge a single allOf subschema into the merged schema. Refer to mergeAllOf for the merging rules.
+func mergeAllOfSubschema(merged *base.Schema, subschema *base.Schema) *SchemaError {
+	mergedTypes, err := intersectAllOfTypes(allOfTypes(merged), allOfTypes(subschema))
+	if err != nil {
+		return SchemaErrorFromNode(err, subschema, Type)
+	}
+	merged.Type = mergedTypes
+
+	// Properties and required
+	if subschema.Properties != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), subschema.Properties) {
+			existingProxy, ok := merged.Properties.Get(pair.Key())
+			if !ok {
+				merged.Properties.Set(pair.Key(), pair.Value())
+				continue
+			}
+
+			merged.Properties.Set(pair.Key(), base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{existingProxy, pair.Value()},
+			}))
+		}
+	}
+
+	for _, required := range subschema.Required {
+		if !slices.Contains(merged.Required, required) {
+			merged.Required = append(merged.Required, required)
+		}
+	}
+
+	// Collections and maps
+	if merged.Items == nil {
+		merged.Items = subschema.Items
+	} else if subschema.Items != nil && merged.Items.IsA() && subschema.Items.IsA() {
+		merged.Items = &base.DynamicValue[*base.SchemaProxy, bool]{
+			A: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{merged.Items.A, subschema.Items.A},
+			}),
+		}
+	}
+
+	if merged.AdditionalProperties == nil {
+		merged.AdditionalProperties = subschema.AdditionalProperties
+	} else if subschema.AdditionalProperties != nil && merged.AdditionalProperties.IsA() && subschema.AdditionalProperties.IsA() {
+		merged.AdditionalProperties = &base.DynamicValue[*base.SchemaProxy, bool]{
+			A: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{merged.AdditionalProperties.A, subschema.AdditionalProperties.A},
+			}),
+		}
+	}
+
+	// Validation keywords
+	merged.Minimum = mostRestrictive(merged.Minimum, subschema.Minimum, func(a, b float64) bool { return a > b })
+	merged.Maximum = mostRestrictive(merged.Maximum, subschema.Maximum, func(a, b float64) bool { return a < b })
+	merged.MinLength = mostRestrictive(merged.MinLength, subschema.MinLength, func(a, b int64) bool { return a > b })
+	merged.MaxLength = mostRestrictive(merged.MaxLength, subschema.MaxLength, func(a, b int64) bool { return a < b })
+	merged.MinItems = mostRestrictive(merged.MinItems, subschema.MinItems, func(a, b int64) bool { return a > b })
+	merged.MaxItems = mostRestrictive(merged.MaxItems, subschema.MaxItems, func(a, b int64) bool { return a < b })
+	merged.MinProperties = mostRestrictive(merged.MinProperties, subschema.MinProperties, func(a, b int64) bool { return a > b })
+	merged.MaxProperties = mostRestrictive(merged.MaxProperties, subschema.MaxProperties, func(a, b int64) bool { return a < b })
+
+	merged.UniqueItems = anyEnabled(merged.UniqueItems, subschema.UniqueItems)
+	merged.ReadOnly = anyEnabled(merged.ReadOnly, subschema.ReadOnly)
+	merged.WriteOnly = anyEnabled(merged.WriteOnly, subschema.WriteOnly)
+	merged.Deprecated = anyEnabled(merged.Deprecated, subschema.Deprecated)
+
+	if len(merged.Enum) == 0 {
+		merged.Enum = subschema.Enum
+	} else if len(subschema.Enum) > 0 {
+		merged.Enum = intersectEnums(merged.Enum, subschema.Enum)
+		if len(merged.Enum) == 0 {
+			return SchemaErrorFromNode(diagnostic.WithCode(diagnostic.CodeUnsupportedAllOf, fmt.Errorf("allOf subschemas have contradictory enum values")), subschema, None)
+		}
+	}
+
+	// All other keywords use the first value found
+	if merged.Format == "" {
+		merged.Format = subschema.Format
+	}
+	if merged.Pattern == "" {
+		merged.Pattern = subschema.Pattern
+	}
+	if merged.Title == "" {
+		merged.Title = subschema.Title
+	}
+	if merged.Default == nil {
+		merged.Default = subschema.Default
+	}
+	if merged.ExclusiveMinimum == nil {
+		merged.ExclusiveMinimum = subschema.ExclusiveMinimum
+	}
+	if merged.ExclusiveMaximum == nil {
+		merged.ExclusiveMaximum = subschema.ExclusiveMaximum
+	}
+	if merged.MultipleOf == nil {
+		merged.MultipleOf = subschema.MultipleOf
+	}
+
+	return nil
+}
+
+// allOfTypes returns the types of a schema, with properties implying an object type. Returns nil if the schema has no type constraint.
+func allOfTypes(s *base.Schema) []string {
+	if len(s.Type) > 0 {
+		return s.Type
+	}
+
+	if s.Properties != nil && s.Properties.Len() > 0 {
+		return []string{util.OAS_type_object}
+	}
+
+	return nil
+}
+
+// intersectAllOfTypes returns the types that are valid for both type arrays. An "integer" type is valid for a "number" type.
+func intersectAllOfTypes(first []string, second []string) ([]string, error) {
+	if len(first) == 0 {
+		return slices.Clone(second), nil
+	}
+	if len(second) == 0 {
+		return first, nil
+	}
+
+	intersection := []string{}
+	for _, firstType := range first {
+		for _, secondType := range second {
+			intersectType := ""
+			switch {
+			case firstType == secondType:
+				intersectType = firstType
+			case firstType == util.OAS_type_integer && secondType == util.OAS_type_number,
+				firstType == util.OAS_type_number && secondType == util.OAS_type_integer:
+				intersectType = util.OAS_type_integer
+			}
+
+			if intersectType != "" && !slices.Contains(intersection, intersectType) {
+				intersection = append(intersection, intersectType)
+			}
+		}
+	}
+
+	if len(intersection) == 0 {
+		return nil, diagnostic.WithCode(diagnostic.CodeUnsupportedAllOf, fmt.Errorf("allOf subschemas have contradictory types %v and %v", first, second))
+	}
+
+	return intersection, nil
+}
+
+// intersectEnums returns the enum values that exist in both enum arrays.
+func intersectEnums(first []*yaml.Node, second []*yaml.Node) []*yaml.Node {
+	intersection := []*yaml.Node{}
+	for _, firstValue := range first {
+		for _, secondValue := range second {
+			if firstValue.Kind == secondValue.Kind && firstValue.Value == secondValue.Value {
+				intersection = append(intersection, firstValue)
+				break
+			}
+		}
+	}
+
+	return intersection
+}
+
+// mostRestrictive returns the most restrictive of the two values, using the isMoreRestrictive function to compare.
+func mostRestrictive[T int64 | float64](first *T, second *T, isMoreRestrictive func(T, T) bool) *T {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+
+	if isMoreRestrictive(*second, *first) {
+		return second
+	}
+	return first
+}
+
+// anyEnabled returns a true pointer if either boolean pointer is true.
+func anyEnabled(first *bool, second *bool) *bool {
+	if first != nil && *first {
+		return first
+	}
+	if second != nil && *second {
+		return second
+	}
+	if first != nil {
+		return first
+	}
+	return second
+}
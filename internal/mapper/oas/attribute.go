@@ -6,7 +6,9 @@ package oas
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
@@ -26,16 +28,29 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:             s.GetIgnoresForNested(name),
+			JSONStrings:         s.GetJSONStringsForNested(name),
+			ForceJSONString:     s.IsPropertyJSONString(name),
+			MultiTypeStrategies: s.GetMultiTypeStrategiesForNested(name),
+			ExactlyOneOf:        s.GetVariantSiblings(name),
 		}
 
-		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
+		pSchema, err := s.buildPropertySchema(name, pProxy, schemaOpts)
 		if err != nil {
+			if s.dropUnmappableProperty(s.NestSchemaError(err, name)) {
+				continue
+			}
 			return nil, s.NestSchemaError(err, name)
 		}
+		if pSchema == nil {
+			continue
+		}
 
-		attribute, err := pSchema.BuildResourceAttribute(name, s.GetComputability(name))
+		attribute, err := pSchema.BuildResourceAttribute(name, s.GetResourceComputability(name, pSchema))
 		if err != nil {
+			if pSchema.GlobalSchemaOpts.dropMultiTypeProperty(err) || s.dropUnmappableProperty(err) {
+				continue
+			}
 			return nil, err
 		}
 
@@ -47,7 +62,11 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 
 func (s *OASSchema) BuildResourceAttribute(name string, computability schema.ComputedOptionalRequired) (attrmapper.ResourceAttribute, *SchemaError) {
 	if util.TerraformIdentifier(name) == "" {
-		return nil, s.SchemaErrorFromProperty(fmt.Errorf("'%s' cannot be converted to a valid Terraform identifier", name), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidIdentifier, fmt.Errorf("'%s' cannot be converted to a valid Terraform identifier", name)), name)
+	}
+
+	if s.IsJSONString() {
+		return s.BuildJSONStringResource(name, computability)
 	}
 
 	switch s.Type {
@@ -67,7 +86,7 @@ func (s *OASSchema) BuildResourceAttribute(name string, computability schema.Com
 		}
 		return s.BuildSingleNestedResource(name, computability)
 	default:
-		return nil, s.SchemaErrorFromProperty(fmt.Errorf("invalid schema type '%s'", s.Type), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidSchemaType, fmt.Errorf("invalid schema type '%s'", s.Type)), name)
 	}
 }
 
@@ -84,16 +103,29 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:             s.GetIgnoresForNested(name),
+			JSONStrings:         s.GetJSONStringsForNested(name),
+			ForceJSONString:     s.IsPropertyJSONString(name),
+			MultiTypeStrategies: s.GetMultiTypeStrategiesForNested(name),
+			ExactlyOneOf:        s.GetVariantSiblings(name),
 		}
 
-		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
+		pSchema, err := s.buildPropertySchema(name, pProxy, schemaOpts)
 		if err != nil {
+			if s.dropUnmappableProperty(s.NestSchemaError(err, name)) {
+				continue
+			}
 			return nil, s.NestSchemaError(err, name)
 		}
+		if pSchema == nil {
+			continue
+		}
 
 		attribute, err := pSchema.BuildDataSourceAttribute(name, s.GetComputability(name))
 		if err != nil {
+			if pSchema.GlobalSchemaOpts.dropMultiTypeProperty(err) || s.dropUnmappableProperty(err) {
+				continue
+			}
 			return nil, err
 		}
 
@@ -105,7 +137,11 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 
 func (s *OASSchema) BuildDataSourceAttribute(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
 	if util.TerraformIdentifier(name) == "" {
-		return nil, s.SchemaErrorFromProperty(fmt.Errorf("'%s' cannot be converted to a valid Terraform identifier", name), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidIdentifier, fmt.Errorf("'%s' cannot be converted to a valid Terraform identifier", name)), name)
+	}
+
+	if s.IsJSONString() {
+		return s.BuildJSONStringDataSource(name, computability)
 	}
 
 	switch s.Type {
@@ -125,7 +161,7 @@ func (s *OASSchema) BuildDataSourceAttribute(name string, computability schema.C
 		}
 		return s.BuildSingleNestedDataSource(name, computability)
 	default:
-		return nil, s.SchemaErrorFromProperty(fmt.Errorf("invalid schema type '%s'", s.Type), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidSchemaType, fmt.Errorf("invalid schema type '%s'", s.Type)), name)
 	}
 }
 
@@ -142,16 +178,29 @@ func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *S
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:             s.GetIgnoresForNested(name),
+			JSONStrings:         s.GetJSONStringsForNested(name),
+			ForceJSONString:     s.IsPropertyJSONString(name),
+			MultiTypeStrategies: s.GetMultiTypeStrategiesForNested(name),
+			ExactlyOneOf:        s.GetVariantSiblings(name),
 		}
 
-		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
+		pSchema, err := s.buildPropertySchema(name, pProxy, schemaOpts)
 		if err != nil {
+			if s.dropUnmappableProperty(s.NestSchemaError(err, name)) {
+				continue
+			}
 			return nil, s.NestSchemaError(err, name)
 		}
+		if pSchema == nil {
+			continue
+		}
 
 		attribute, err := pSchema.BuildProviderAttribute(name, s.GetOptionalOrRequired(name))
 		if err != nil {
+			if pSchema.GlobalSchemaOpts.dropMultiTypeProperty(err) || s.dropUnmappableProperty(err) {
+				continue
+			}
 			return nil, err
 		}
 
@@ -163,7 +212,11 @@ func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *S
 
 func (s *OASSchema) BuildProviderAttribute(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
 	if util.TerraformIdentifier(name) == "" {
-		return nil, s.SchemaErrorFromProperty(fmt.Errorf("'%s' cannot be converted to a valid Terraform identifier", name), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidIdentifier, fmt.Errorf("'%s' cannot be converted to a valid Terraform identifier", name)), name)
+	}
+
+	if s.IsJSONString() {
+		return s.BuildJSONStringProvider(name, optionalOrRequired)
 	}
 
 	switch s.Type {
@@ -183,6 +236,19 @@ func (s *OASSchema) BuildProviderAttribute(name string, optionalOrRequired schem
 		}
 		return s.BuildSingleNestedProvider(name, optionalOrRequired)
 	default:
-		return nil, s.SchemaErrorFromProperty(fmt.Errorf("invalid schema type '%s'", s.Type), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidSchemaType, fmt.Errorf("invalid schema type '%s'", s.Type)), name)
+	}
+}
+
+// dropUnmappableProperty will drop a property that returned a SchemaError if GlobalSchemaOpts.BestEffort is enabled, adding the error
+// as a warning with an absolute path. Returns true if the property was dropped.
+func (s *OASSchema) dropUnmappableProperty(err *SchemaError) bool {
+	if !s.GlobalSchemaOpts.BestEffort {
+		return false
 	}
+
+	path := append(slices.Clone(s.GlobalSchemaOpts.attributePath), err.path...)
+	s.GlobalSchemaOpts.Warnings.Add(err.withPath(path...))
+
+	return true
 }
@@ -18,6 +18,7 @@ func (s *OASSchema) BuildBoolResource(name string, computability schema.Computed
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -44,6 +45,7 @@ func (s *OASSchema) BuildBoolDataSource(name string, computability schema.Comput
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -57,6 +59,7 @@ func (s *OASSchema) BuildBoolProvider(name string, optionalOrRequired schema.Opt
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 		},
 	}, nil
 }
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -16,11 +18,12 @@ import (
 	"github.com/pb33f/libopenapi/orderedmap"
 )
 
-var ErrMultiTypeSchema = errors.New("unsupported multi-type, attribute cannot be created")
-var ErrSchemaNotFound = errors.New("no compatible schema found")
+var ErrMultiTypeSchema = diagnostic.WithCode(diagnostic.CodeMultiType, errors.New("unsupported multi-type, attribute cannot be created"))
+var ErrSchemaNotFound = diagnostic.WithCode(diagnostic.CodeSchemaNotFound, errors.New("no compatible schema found"))
 
 // BuildSchemaFromRequest will extract and build the schema from the request body of an operation
-//   - Media type will default to "application/json", then continue to the next available media type with a schema
+//   - Media type will default to "application/json", then continue to the next available JSON media type (like "application/vnd.api+json")
+//     with a schema, then continue to the next available media type with a schema. Media type can be selected with SchemaOpts.MediaType.
 func BuildSchemaFromRequest(op *high.Operation, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, error) {
 	if op == nil || op.RequestBody == nil || op.RequestBody.Content == nil || op.RequestBody.Content.Len() == 0 {
 		return nil, ErrSchemaNotFound
@@ -30,13 +33,24 @@ func BuildSchemaFromRequest(op *high.Operation, schemaOpts SchemaOpts, globalOpt
 }
 
 // BuildSchemaFromResponse will extract and build the schema from the response body of an operation
-//   - Response codes of 200 and then 201 will be prioritized, then will continue to the next available 2xx code
-//   - Media type will default to "application/json", then continue to the next available media type with a schema
+//   - Response codes of 200 and then 201 will be prioritized, then will continue to the next available 2xx code. Response code can
+//     be selected with SchemaOpts.ResponseCode.
+//   - Media type will default to "application/json", then continue to the next available JSON media type (like "application/vnd.api+json")
+//     with a schema, then continue to the next available media type with a schema. Media type can be selected with SchemaOpts.MediaType.
 func BuildSchemaFromResponse(op *high.Operation, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, error) {
 	if op == nil || op.Responses == nil || op.Responses.Codes == nil || op.Responses.Codes.Len() == 0 {
 		return nil, ErrSchemaNotFound
 	}
 
+	if schemaOpts.ResponseCode != "" {
+		response, ok := op.Responses.Codes.Get(schemaOpts.ResponseCode)
+		if !ok || response == nil {
+			return nil, diagnostic.WithCode(diagnostic.CodeSchemaNotFound, fmt.Errorf("response code '%s' not found", schemaOpts.ResponseCode))
+		}
+
+		return getSchemaFromMediaType(response.Content, schemaOpts, globalOpts)
+	}
+
 	okResponse, ok := op.Responses.Codes.Get(util.OAS_response_code_ok)
 	if ok {
 		return getSchemaFromMediaType(okResponse.Content, schemaOpts, globalOpts)
@@ -68,20 +82,38 @@ func getSchemaFromMediaType(mediaTypes *orderedmap.Map[string, *high.MediaType],
 		return nil, ErrSchemaNotFound
 	}
 
+	if schemaOpts.MediaType != "" {
+		mediaType, ok := mediaTypes.Get(schemaOpts.MediaType)
+		if !ok || mediaType == nil || mediaType.Schema == nil {
+			return nil, diagnostic.WithCode(diagnostic.CodeSchemaNotFound, fmt.Errorf("media type '%s' with a schema not found", schemaOpts.MediaType))
+		}
+
+		s, err := buildBodySchema(mediaType.Schema, schemaOpts, globalOpts)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+
 	jsonMediaType, ok := mediaTypes.Get(util.OAS_mediatype_json)
 	if ok && jsonMediaType.Schema != nil {
-		s, err := BuildSchema(jsonMediaType.Schema, schemaOpts, globalOpts)
+		s, err := buildBodySchema(jsonMediaType.Schema, schemaOpts, globalOpts)
 		if err != nil {
 			return nil, err
 		}
 		return s, nil
 	}
 
+	// JSON media types are prioritized over all other media types
 	sortedMediaTypes := orderedmap.SortAlpha(mediaTypes)
-	for pair := range orderedmap.Iterate(context.TODO(), sortedMediaTypes) {
-		mediaType := pair.Value()
-		if mediaType.Schema != nil {
-			s, err := BuildSchema(mediaType.Schema, schemaOpts, globalOpts)
+	for _, jsonOnly := range []bool{true, false} {
+		for pair := range orderedmap.Iterate(context.TODO(), sortedMediaTypes) {
+			mediaType := pair.Value()
+			if mediaType.Schema == nil || (jsonOnly && !isJSONMediaType(pair.Key())) {
+				continue
+			}
+
+			s, err := buildBodySchema(mediaType.Schema, schemaOpts, globalOpts)
 			if err != nil {
 				return nil, err
 			}
@@ -92,23 +124,83 @@ func getSchemaFromMediaType(mediaTypes *orderedmap.Map[string, *high.MediaType],
 	return nil, ErrSchemaNotFound
 }
 
+// isJSONMediaType determines if a media type is JSON, either "application/json" or a media type with a "+json" structured syntax
+// suffix, like "application/vnd.api+json". Media type parameters, like "; charset=utf-8", are not considered.
+func isJSONMediaType(mediaType string) bool {
+	mediaType, _, _ = strings.Cut(mediaType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+
+	return mediaType == util.OAS_mediatype_json || strings.HasSuffix(mediaType, util.OAS_mediatype_json_suffix)
+}
+
+// buildBodySchema will build the schema of a request or response body. If a schema path is set in the schema options, the nested
+// schema at that path will be built instead, with the schema options only applying to the nested schema.
+func buildBodySchema(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, *SchemaError) {
+	schemaPath := schemaOpts.SchemaPath
+
+	// Request and response body options are not relevant to the built schema
+	schemaOpts.SchemaPath = nil
+	schemaOpts.ResponseCode = ""
+	schemaOpts.MediaType = ""
+
+	if len(schemaPath) == 0 {
+		return BuildSchema(proxy, schemaOpts, globalOpts)
+	}
+
+	s, err := BuildSchema(proxy, SchemaOpts{}, globalOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, propName := range schemaPath {
+		if s.Type != util.OAS_type_object || s.Schema.Properties == nil {
+			return nil, SchemaErrorFromNode(diagnostic.WithCode(diagnostic.CodeInvalidSchemaPath, fmt.Errorf("invalid schema path '%s', expected an object with a '%s' property", strings.Join(schemaPath, "."), propName)), s.Schema, Type)
+		}
+
+		propProxy, ok := s.Schema.Properties.Get(propName)
+		if !ok {
+			return nil, SchemaErrorFromNode(diagnostic.WithCode(diagnostic.CodeInvalidSchemaPath, fmt.Errorf("invalid schema path '%s', '%s' property not found", strings.Join(schemaPath, "."), propName)), s.Schema, Type)
+		}
+
+		// Schema options only apply to the final schema in the path, as ignores are relative to the unwrapped schema
+		propSchemaOpts := SchemaOpts{}
+		if i == len(schemaPath)-1 {
+			propSchemaOpts = schemaOpts
+		}
+
+		propSchema, err := BuildSchema(propProxy, propSchemaOpts, globalOpts)
+		if err != nil {
+			return nil, s.NestSchemaError(err, propName)
+		}
+
+		s = propSchema
+	}
+
+	return s, nil
+}
+
 // BuildSchema will build a schema from a schema proxy. It can also handle nullable schemas/types,
 // implemented with oneOf/anyOf OAS keywords or an array on the "type" property
 func BuildSchema(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts) (*OASSchema, *SchemaError) {
 	resp := OASSchema{}
 
-	s, err := buildSchemaProxy(proxy)
+	globalOpts, err := globalOpts.pushRecursionStack(proxy)
 	if err != nil {
 		return nil, err
 	}
 
+	s, err := buildSchemaProxy(proxy)
+	if err != nil {
+		return buildMultiTypeFallback(proxy, schemaOpts, globalOpts, err)
+	}
+
 	resp.SchemaOpts = schemaOpts
 	resp.GlobalSchemaOpts = globalOpts
 	resp.Schema = s
 
 	oasType, err := retrieveType(resp.Schema)
 	if err != nil {
-		return nil, err
+		return buildMultiTypeFallback(proxy, schemaOpts, globalOpts, err)
 	}
 
 	resp.Type = oasType
@@ -118,9 +210,9 @@ func BuildSchema(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts Glob
 }
 
 // buildSchemaProxy is a helper function that builds a schema proxy. If needed, it will recursively resolve a specific set of [schema composition] keywords:
-//   - allOf: If len == 1, will resolve with that one item.
-//   - anyOf: If len == 2, will resolve nullable or stringable types
-//   - oneOf: If len == 2, will resolve nullable or stringable types
+//   - allOf: If len == 1, will resolve with that one item. Otherwise, will merge all subschemas into one schema.
+//   - anyOf: If all subschemas are objects (or null), will resolve to an object with one property per variant. Otherwise, if len == 2, will resolve nullable or stringable types
+//   - oneOf: If all subschemas are objects (or null), will resolve to an object with one property per variant. Otherwise, if len == 2, will resolve nullable or stringable types
 //
 // # Any other combinations of allOf, anyOf, or oneOf will return a SchemaError
 //
@@ -128,7 +220,7 @@ func BuildSchema(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts Glob
 func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 	s, err := proxy.BuildSchema()
 	if err != nil {
-		return nil, SchemaErrorFromProxy(fmt.Errorf("failed to build schema proxy - %w", err), proxy)
+		return nil, SchemaErrorFromProxy(diagnostic.WithCode(diagnostic.CodeInvalidSchemaReference, fmt.Errorf("failed to build schema proxy - %w", err)), proxy)
 	}
 
 	// If there are no schema composition keywords, return the schema
@@ -137,6 +229,14 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 	}
 
 	if len(s.AnyOf) > 0 {
+		variantSchema, err := buildVariantSchema(s, s.AnyOf, AnyOf)
+		if err != nil {
+			return nil, err
+		}
+		if variantSchema != nil {
+			return variantSchema, nil
+		}
+
 		if len(s.AnyOf) == 2 {
 			schema, err := getMultiTypeSchema(s.AnyOf[0], s.AnyOf[1])
 			if err != nil {
@@ -147,10 +247,18 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		}
 
 		// Dynamic type currently not supported
-		return nil, SchemaErrorFromNode(fmt.Errorf("found %d anyOf subschema(s), schema composition is currently not supported", len(s.AnyOf)), s, AnyOf)
+		return nil, SchemaErrorFromNode(fmt.Errorf("found %d anyOf subschema(s), schema composition is currently not supported - %w", len(s.AnyOf), ErrMultiTypeSchema), s, AnyOf)
 	}
 
 	if len(s.OneOf) > 0 {
+		variantSchema, err := buildVariantSchema(s, s.OneOf, OneOf)
+		if err != nil {
+			return nil, err
+		}
+		if variantSchema != nil {
+			return variantSchema, nil
+		}
+
 		if len(s.OneOf) == 2 {
 			schema, err := getMultiTypeSchema(s.OneOf[0], s.OneOf[1])
 			if err != nil {
@@ -161,11 +269,11 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		}
 
 		// Dynamic type currently not supported
-		return nil, SchemaErrorFromNode(fmt.Errorf("found %d oneOf subschema(s), schema composition is currently not supported", len(s.OneOf)), s, OneOf)
+		return nil, SchemaErrorFromNode(fmt.Errorf("found %d oneOf subschema(s), schema composition is currently not supported - %w", len(s.OneOf), ErrMultiTypeSchema), s, OneOf)
 	}
 
 	// If there is just one allOf, we can use it as the schema
-	if len(s.AllOf) == 1 {
+	if len(s.AllOf) == 1 && len(s.Type) == 0 && s.Properties == nil {
 		allOfSchema, err := buildSchemaProxy(s.AllOf[0])
 		if err != nil {
 			return nil, err
@@ -179,9 +287,8 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		return allOfSchema, nil
 	}
 
-	// Combining multiple allOf schemas and their properties is possible here, but currently not supported
-	// See: https://github.com/hashicorp/terraform-plugin-codegen-openapi/issues/56
-	return nil, SchemaErrorFromNode(fmt.Errorf("found %d allOf subschema(s), schema composition is currently not supported", len(s.AllOf)), s, AllOf)
+	// Multiple allOf subschemas are combined into one schema
+	return mergeAllOf(s)
 }
 
 // getMultiTypeSchema will check the types of both schemas provided and will return the non-null schema. If a null schema type is not
@@ -234,7 +341,12 @@ func retrieveType(schema *base.Schema) (string, *SchemaError) {
 			return util.OAS_type_object, nil
 		}
 
-		return "", SchemaErrorFromProxy(errors.New("no 'type' array or supported allOf, oneOf, anyOf constraint - attribute cannot be created"), schema.ParentProxy)
+		// Kubernetes schemas that preserve unknown fields often omit the type, which can be any JSON object
+		if hasPreserveUnknownFields(schema) {
+			return util.OAS_type_object, nil
+		}
+
+		return "", SchemaErrorFromProxy(diagnostic.WithCode(diagnostic.CodeMissingSchemaType, errors.New("no 'type' array or supported allOf, oneOf, anyOf constraint - attribute cannot be created")), schema.ParentProxy)
 	case 1:
 		return schema.Type[0], nil
 	case 2:
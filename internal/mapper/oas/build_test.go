@@ -4,19 +4,26 @@
 package oas_test
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
+	"sort"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 func TestBuildSchemaFromRequest(t *testing.T) {
@@ -80,6 +87,66 @@ func TestBuildSchemaFromRequest(t *testing.T) {
 				},
 			},
 		},
+		"prioritizes json media types": {
+			op: &high.Operation{
+				RequestBody: &high.RequestBody{
+					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+						"application/octet-stream": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this won't be used because it's not json!",
+								Type:        []string{"boolean"},
+							}),
+						},
+						"application/vnd.foo+json": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this will get used because it's json!",
+								Type:        []string{"string"},
+							}),
+						},
+						"text/plain": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this won't be used because it's not json!",
+								Type:        []string{"boolean"},
+							}),
+						},
+					}),
+				},
+			},
+			expectedSchema: &oas.OASSchema{
+				Type: "string",
+				Schema: &base.Schema{
+					Description: "this will get used because it's json!",
+					Type:        []string{"string"},
+				},
+			},
+		},
+		"prioritizes json media types with parameters": {
+			op: &high.Operation{
+				RequestBody: &high.RequestBody{
+					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+						"application/hal+json; charset=utf-8": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this will get used because it's json!",
+								Type:        []string{"string"},
+							}),
+						},
+						"application/xml": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this won't be used because it's not json!",
+								Type:        []string{"boolean"},
+							}),
+						},
+					}),
+				},
+			},
+			expectedSchema: &oas.OASSchema{
+				Type: "string",
+				Schema: &base.Schema{
+					Description: "this will get used because it's json!",
+					Type:        []string{"string"},
+				},
+			},
+		},
 		"utilizes other media types when nil schemas in priority media types": {
 			op: &high.Operation{
 				RequestBody: &high.RequestBody{
@@ -120,7 +187,7 @@ func TestBuildSchemaFromRequest(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err)
 			}
 
-			if diff := cmp.Diff(got, testCase.expectedSchema, cmpopts.IgnoreUnexported(base.Schema{}, oas.OASSchema{})); diff != "" {
+			if diff := cmp.Diff(got, testCase.expectedSchema, cmpopts.IgnoreUnexported(base.Schema{}, oas.OASSchema{}, oas.GlobalSchemaOpts{})); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
@@ -330,7 +397,7 @@ func TestBuildSchemaFromResponse(t *testing.T) {
 				t.Fatalf("unexpected error: %s", err)
 			}
 
-			if diff := cmp.Diff(got, testCase.expectedSchema, cmpopts.IgnoreUnexported(base.Schema{}, oas.OASSchema{})); diff != "" {
+			if diff := cmp.Diff(got, testCase.expectedSchema, cmpopts.IgnoreUnexported(base.Schema{}, oas.OASSchema{}, oas.GlobalSchemaOpts{})); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
@@ -1025,6 +1092,132 @@ func TestBuildSchema_AllOfSchemaComposition(t *testing.T) {
 				},
 			},
 		},
+		"allOf with multiple elements - merge subschemas": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"object"},
+						Required: []string{"id"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm a string type from the base schema, required.",
+							}),
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm a string type from the base schema.",
+							}),
+							"count": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"number"},
+								Description: "hey there! I'm a number type from the base schema.",
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Required: []string{"name"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"name": base.CreateSchemaProxy(&base.Schema{
+								MinLength: pointer(int64(1)),
+							}),
+							"count": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+							"bool": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"boolean"},
+								Description: "hey there! I'm a bool type from the extended schema.",
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a bool type from the extended schema."),
+					},
+				},
+				&attrmapper.ResourceInt64Attribute{
+					Name: "count",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a number type from the base schema."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a string type from the base schema, required."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a string type from the base schema."),
+						Validators: schema.StringValidators{
+							{
+								Custom: frameworkvalidators.StringValidatorLengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+		"allOf with multiple elements - parent properties and combined descriptions": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"nested_object": base.CreateSchemaProxy(&base.Schema{
+						AllOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"object"},
+								Description: "hey there! I'm the base object.",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"string": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"object"},
+								Description: "hey there! I'm the extended object.",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bool": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"boolean"},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "nested_object",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "bool",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+						&attrmapper.ResourceStringAttribute{
+							Name: "string",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm the base object.\n\nhey there! I'm the extended object."),
+					},
+				},
+			},
+		},
 		"allOf with one element - use subschema and override description": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
 				Type:     []string{"object"},
@@ -1092,98 +1285,1511 @@ func TestBuildSchema_AllOfSchemaComposition(t *testing.T) {
 	}
 }
 
-func TestBuildSchema_Errors(t *testing.T) {
+func TestBuildSchema_PolymorphicVariants(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		schemaProxy      *base.SchemaProxy
-		expectedErrRegex string
+		schemaProxy        *base.SchemaProxy
+		expectedAttributes attrmapper.ResourceAttributes
 	}{
-		"no type or schema composition": {
+		"oneOf with object variants - named from title": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				Type: []string{},
-			}),
-			expectedErrRegex: `no 'type' array or supported allOf, oneOf, anyOf constraint - attribute cannot be created`,
-		},
-		"unsupported multi-type array": {
-			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				Type: []string{"string", "object"},
-			}),
-			expectedErrRegex: `\[string object\] - unsupported multi-type, attribute cannot be created`,
-		},
-		"unsupported multi-type oneOf": {
-			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				OneOf: []*base.SchemaProxy{
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"string"},
-					}),
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"object"},
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"pet": base.CreateSchemaProxy(&base.Schema{
+						Description: "hey there! I'm a polymorphic object.",
+						OneOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type:     []string{"object"},
+								Title:    "Cat",
+								Required: []string{"meow"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"meow": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"boolean"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"object"},
+								Title:       "Dog",
+								Description: "hey there! I'm a dog variant.",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bark": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						},
 					}),
-				},
+				}),
 			}),
-			expectedErrRegex: `\[string object\] - unsupported multi-type, attribute cannot be created`,
-		},
-		"unsupported multi-type anyOf": {
-			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				OneOf: []*base.SchemaProxy{
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"object"},
-					}),
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"string"},
-					}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "pet",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "Cat",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceBoolAttribute{
+									Name: "meow",
+									BoolAttribute: resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Required,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: schema.ObjectValidators{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"dog"}),
+									},
+								},
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "Dog",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "bark",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Description:              pointer("hey there! I'm a dog variant."),
+								Validators: schema.ObjectValidators{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"cat"}),
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a polymorphic object."),
+					},
 				},
-			}),
-			expectedErrRegex: `\[object string\] - unsupported multi-type, attribute cannot be created`,
+			},
 		},
-		"too many allOf": {
+		"anyOf with nullable object variants": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				AllOf: []*base.SchemaProxy{
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"null"},
-					}),
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"string"},
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"payment": base.CreateSchemaProxy(&base.Schema{
+						AnyOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"object"},
+								Title: "Credit Card",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"number": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"null"},
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "BankAccount",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"iban": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"object"},
+								Title: "Voucher",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"code": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						},
 					}),
-				},
+				}),
 			}),
-			expectedErrRegex: `found 2 allOf subschema\(s\), schema composition is currently not supported`,
-		},
-		"too many anyOf": {
-			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				AnyOf: []*base.SchemaProxy{
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"null"},
-					}),
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"string"},
-					}),
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"integer"},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "payment",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "BankAccount",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "iban",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: schema.ObjectValidators{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"credit_card", "voucher"}),
+									},
+								},
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "Credit Card",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "number",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: schema.ObjectValidators{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"bank_account", "voucher"}),
+									},
+								},
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "Voucher",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "code",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: schema.ObjectValidators{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"credit_card", "bank_account"}),
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, err := schema.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_PolymorphicVariants_References(t *testing.T) {
+	t.Parallel()
+
+	testOAS := `
+openapi: 3.1.0
+info:
+  title: Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        pet:
+          oneOf:
+            - $ref: '#/components/schemas/Cat'
+            - $ref: '#/components/schemas/Dog'
+    DiscriminatedPet:
+      type: object
+      properties:
+        pet:
+          oneOf:
+            - $ref: '#/components/schemas/Cat'
+            - $ref: '#/components/schemas/Dog'
+          discriminator:
+            propertyName: pet_type
+            mapping:
+              cat: '#/components/schemas/Cat'
+              kitten: '#/components/schemas/Cat'
+    Cat:
+      type: object
+      required:
+        - pet_type
+      properties:
+        pet_type:
+          type: string
+          description: The type of pet.
+        meow:
+          type: boolean
+    Dog:
+      type: object
+      required:
+        - pet_type
+      properties:
+        pet_type:
+          type: string
+        bark:
+          type: string
+`
+
+	doc, err := libopenapi.NewDocument([]byte(testOAS))
+	if err != nil {
+		t.Fatalf("unexpected error parsing test OAS: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error building test OAS: %s", errors.Join(errs...))
+	}
+
+	testCases := map[string]struct {
+		schemaName         string
+		schemaOpts         oas.SchemaOpts
+		globalOpts         oas.GlobalSchemaOpts
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"variants named from $ref - computed variants have no validators": {
+			schemaName: "Pet",
+			globalOpts: oas.GlobalSchemaOpts{OverrideComputability: schema.Computed},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "pet",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "Cat",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceBoolAttribute{
+									Name: "meow",
+									BoolAttribute: resource.BoolAttribute{
+										ComputedOptionalRequired: schema.Computed,
+									},
+								},
+								&attrmapper.ResourceStringAttribute{
+									Name: "pet_type",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.Computed,
+										Description:              pointer("The type of pet."),
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Computed,
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "Dog",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "bark",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.Computed,
+									},
+								},
+								&attrmapper.ResourceStringAttribute{
+									Name: "pet_type",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.Computed,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.Computed,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+		"variants named from discriminator mapping": {
+			schemaName: "DiscriminatedPet",
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "pet",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "Dog",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "bark",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: schema.ObjectValidators{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"cat"}),
+									},
+								},
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "cat",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceBoolAttribute{
+									Name: "meow",
+									BoolAttribute: resource.BoolAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators: schema.ObjectValidators{
+									{
+										Custom: frameworkvalidators.ObjectValidatorExactlyOneOf([]string{"dog"}),
+									},
+								},
+							},
+						},
+						&attrmapper.ResourceStringAttribute{
+							Name: "pet_type",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+								Description:              pointer("The type of pet."),
+								Validators: schema.StringValidators{
+									{
+										Custom: frameworkvalidators.StringValidatorOneOf([]string{"cat", "kitten", "Dog"}),
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"variant properties can be ignored": {
+			schemaName: "DiscriminatedPet",
+			schemaOpts: oas.SchemaOpts{
+				Ignores: []string{"pet.cat.meow", "pet.Dog"},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "pet",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name:       "cat",
+							Attributes: attrmapper.ResourceAttributes{},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+						&attrmapper.ResourceStringAttribute{
+							Name: "pet_type",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+								Description:              pointer("The type of pet."),
+								Validators: schema.StringValidators{
+									{
+										Custom: frameworkvalidators.StringValidatorOneOf([]string{"cat", "kitten", "Dog"}),
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			proxy, ok := model.Model.Components.Schemas.Get(testCase.schemaName)
+			if !ok {
+				t.Fatalf("schema %q not found in test OAS", testCase.schemaName)
+			}
+
+			s, err := oas.BuildSchema(proxy, testCase.schemaOpts, testCase.globalOpts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, err := s.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_RecursiveSchemas(t *testing.T) {
+	t.Parallel()
+
+	testOAS := `
+openapi: 3.1.0
+info:
+  title: Test
+  version: 1.0.0
+paths:
+  /nodes/{id}:
+    get:
+      responses:
+        '200':
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Node'
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        name:
+          type: string
+        children:
+          type: array
+          description: The child nodes.
+          items:
+            $ref: '#/components/schemas/Node'
+`
+
+	doc, err := libopenapi.NewDocument([]byte(testOAS))
+	if err != nil {
+		t.Fatalf("unexpected error parsing test OAS: %s", err)
+	}
+
+	model, errs := doc.BuildV3Model()
+	if len(errs) > 0 {
+		t.Fatalf("unexpected error building test OAS: %s", errors.Join(errs...))
+	}
+
+	testCases := map[string]struct {
+		recursionOpts      oas.RecursionOpts
+		expectedAttributes attrmapper.ResourceAttributes
+		expectedWarnings   []string
+	}{
+		"default - json strategy": {
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "children",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("The child nodes."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"json strategy - max depth of 1": {
+			recursionOpts: oas.RecursionOpts{
+				MaxDepth: 1,
+				Strategy: oas.RecursionStrategyJSON,
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListNestedAttribute{
+					Name: "children",
+					NestedObject: attrmapper.ResourceNestedAttributeObject{
+						Attributes: attrmapper.ResourceAttributes{
+							&attrmapper.ResourceStringAttribute{
+								Name: "children",
+								StringAttribute: resource.StringAttribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+									CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+									Description:              pointer("The child nodes."),
+								},
+							},
+							&attrmapper.ResourceStringAttribute{
+								Name: "name",
+								StringAttribute: resource.StringAttribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+								},
+							},
+						},
+					},
+					ListNestedAttribute: resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("The child nodes."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+		"drop strategy": {
+			recursionOpts: oas.RecursionOpts{
+				Strategy: oas.RecursionStrategyDrop,
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			expectedWarnings: []string{
+				"children: recursive schema exceeds max depth of 0, dropping property - cycle path: Node -> Node",
+			},
+		},
+		"drop strategy - max depth of 1": {
+			recursionOpts: oas.RecursionOpts{
+				MaxDepth: 1,
+				Strategy: oas.RecursionStrategyDrop,
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListNestedAttribute{
+					Name: "children",
+					NestedObject: attrmapper.ResourceNestedAttributeObject{
+						Attributes: attrmapper.ResourceAttributes{
+							&attrmapper.ResourceStringAttribute{
+								Name: "name",
+								StringAttribute: resource.StringAttribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+								},
+							},
+						},
+					},
+					ListNestedAttribute: resource.ListNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("The child nodes."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+			expectedWarnings: []string{
+				"children.children: recursive schema exceeds max depth of 1, dropping property - cycle path: Node -> Node -> Node",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pathItem, ok := model.Model.Paths.PathItems.Get("/nodes/{id}")
+			if !ok {
+				t.Fatal("path \"/nodes/{id}\" not found in test OAS")
+			}
+
+			warnings := &oas.SchemaWarnings{}
+			globalOpts := oas.GlobalSchemaOpts{
+				OverrideComputability: schema.ComputedOptional,
+				RecursionOpts:         testCase.recursionOpts,
+				Warnings:              warnings,
+			}
+
+			s, err := oas.BuildSchemaFromResponse(pathItem.Get, oas.SchemaOpts{}, globalOpts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, schemaErr := s.BuildResourceAttributes()
+			if schemaErr != nil {
+				t.Fatalf("unexpected error: %s", schemaErr)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			var gotWarnings []string
+			for _, warning := range warnings.All() {
+				gotWarnings = append(gotWarnings, fmt.Sprintf("%s: %s", warning.Path(), warning.Error()))
+			}
+
+			if diff := cmp.Diff(gotWarnings, testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected difference in warnings: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_MultiTypeStrategies(t *testing.T) {
+	t.Parallel()
+
+	testSchema := &base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"primitive_prop": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"integer", "number", "boolean"},
+				Description: "hey there! I'm an integer, number, or boolean.",
+			}),
+			"array_prop": base.CreateSchemaProxy(&base.Schema{
+				Description: "hey there! I'm a string or an array of strings.",
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+					}),
+				},
+			}),
+			"object_prop": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"integer", "object"},
+				Description: "hey there! I'm an integer or an object.",
+			}),
+		}),
+	}
+
+	testCases := map[string]struct {
+		strategy           string
+		strategies         map[string]string
+		expectedAttributes attrmapper.ResourceAttributes
+		expectedWarnings   []string
+	}{
+		"json strategy": {
+			strategy: oas.MultiTypeStrategyJSON,
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "array_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm a string or an array of strings."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "object_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an integer or an object."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "primitive_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an integer, number, or boolean."),
+					},
+				},
+			},
+		},
+		"widest strategy": {
+			strategy: oas.MultiTypeStrategyWidest,
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListAttribute{
+					Name: "array_prop",
+					ListAttribute: resource.ListAttribute{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a string or an array of strings."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "object_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an integer or an object."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "primitive_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm an integer, number, or boolean."),
+					},
+				},
+			},
+		},
+		"drop strategy": {
+			strategy:           oas.MultiTypeStrategyDrop,
+			expectedAttributes: attrmapper.ResourceAttributes{},
+			expectedWarnings: []string{
+				"array_prop: [string array] - unsupported multi-type, attribute cannot be created",
+				"object_prop: [integer object] - unsupported multi-type, attribute cannot be created",
+				"primitive_prop: [integer number boolean] - unsupported multi-type, attribute cannot be created",
+			},
+		},
+		"attribute strategy overrides global strategy": {
+			strategy: oas.MultiTypeStrategyDrop,
+			strategies: map[string]string{
+				"primitive_prop": oas.MultiTypeStrategyWidest,
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "primitive_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm an integer, number, or boolean."),
+					},
+				},
+			},
+			expectedWarnings: []string{
+				"array_prop: [string array] - unsupported multi-type, attribute cannot be created",
+				"object_prop: [integer object] - unsupported multi-type, attribute cannot be created",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			warnings := &oas.SchemaWarnings{}
+			schemaOpts := oas.SchemaOpts{
+				MultiTypeStrategies: testCase.strategies,
+			}
+			globalOpts := oas.GlobalSchemaOpts{
+				OverrideComputability: schema.ComputedOptional,
+				MultiTypeStrategy:     testCase.strategy,
+				Warnings:              warnings,
+			}
+
+			s, err := oas.BuildSchema(base.CreateSchemaProxy(testSchema), schemaOpts, globalOpts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, schemaErr := s.BuildResourceAttributes()
+			if schemaErr != nil {
+				t.Fatalf("unexpected error: %s", schemaErr)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			var gotWarnings []string
+			for _, warning := range warnings.All() {
+				gotWarnings = append(gotWarnings, fmt.Sprintf("%s: %s", warning.Path(), warning.Error()))
+			}
+			sort.Strings(gotWarnings)
+
+			if diff := cmp.Diff(gotWarnings, testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected difference in warnings: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_ReadOnlyWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testSchema := &base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"id", "password"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"string"},
+				ReadOnly: pointer(true),
+			}),
+			"password": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"string"},
+				Description: "hey there! I'm a write-only string.",
+				WriteOnly:   pointer(true),
+			}),
+			"nested_obj": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"created_at": base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						ReadOnly: pointer(true),
+					}),
+					"secret_number": base.CreateSchemaProxy(&base.Schema{
+						Type:      []string{"integer"},
+						WriteOnly: pointer(true),
+					}),
+				}),
+			}),
+			"read_only_obj": base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				ReadOnly: pointer(true),
+				Required: []string{"state"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"state": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+		}),
+	}
+
+	expectedAttributes := attrmapper.ResourceAttributes{
+		&attrmapper.ResourceStringAttribute{
+			Name: "id",
+			StringAttribute: resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+			},
+		},
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "nested_obj",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "created_at",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.ResourceInt64Attribute{
+					Name: "secret_number",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("This attribute is write-only and is not returned by the API."),
+						Sensitive:                pointer(true),
+					},
+				},
+			},
+			SingleNestedAttribute: resource.SingleNestedAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+			},
+		},
+		&attrmapper.ResourceStringAttribute{
+			Name: "password",
+			StringAttribute: resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+				Description:              pointer("hey there! I'm a write-only string. This attribute is write-only and is not returned by the API."),
+				Sensitive:                pointer(true),
+			},
+		},
+		&attrmapper.ResourceSingleNestedAttribute{
+			Name: "read_only_obj",
+			Attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "state",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+			SingleNestedAttribute: resource.SingleNestedAttribute{
+				ComputedOptionalRequired: schema.Computed,
+			},
+		},
+	}
+
+	s, err := oas.BuildSchema(base.CreateSchemaProxy(testSchema), oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attributes, schemaErr := s.BuildResourceAttributes()
+	if schemaErr != nil {
+		t.Fatalf("unexpected error: %s", schemaErr)
+	}
+
+	if diff := cmp.Diff(attributes, expectedAttributes); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestBuildSchema_Errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemaProxy      *base.SchemaProxy
+		expectedErrRegex string
+	}{
+		"no type or schema composition": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{},
+			}),
+			expectedErrRegex: `no 'type' array or supported allOf, oneOf, anyOf constraint - attribute cannot be created`,
+		},
+		"unsupported multi-type array": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string", "object"},
+			}),
+			expectedErrRegex: `\[string object\] - unsupported multi-type, attribute cannot be created`,
+		},
+		"unsupported multi-type oneOf": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+					}),
+				},
+			}),
+			expectedErrRegex: `\[string object\] - unsupported multi-type, attribute cannot be created`,
+		},
+		"unsupported multi-type anyOf": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			}),
+			expectedErrRegex: `\[object string\] - unsupported multi-type, attribute cannot be created`,
+		},
+		"oneOf object variant without a $ref or title": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"object"},
+						Title: "Cat",
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+					}),
+				},
+			}),
+			expectedErrRegex: `oneOf subschema at index 1 must be a \$ref or have a title to be mapped as a variant`,
+		},
+		"anyOf object variants with duplicate names": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AnyOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"object"},
+						Title: "Cat",
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"object"},
+						Title: "Cat",
+					}),
+				},
+			}),
+			expectedErrRegex: `anyOf subschema at index 1 has variant name 'Cat', which is already in use`,
+		},
+		"discriminator property with the same name as a variant": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"object"},
+						Title: "kind",
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"object"},
+						Title: "other",
+					}),
+				},
+				Discriminator: &base.Discriminator{
+					PropertyName: "kind",
+				},
+			}),
+			expectedErrRegex: `discriminator property 'kind' has the same name as a variant`,
+		},
+		"allOf with contradictory types": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"null"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschemas have contradictory types \[null\] and \[string\]`,
+		},
+		"allOf with contradictory nested property types": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschemas have contradictory types \[object\] and \[array\]`,
+		},
+		"allOf with contradictory enums": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "one"},
+						},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "two"},
+						},
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschemas have contradictory enum values`,
+		},
+		"too many anyOf": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AnyOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"null"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				},
+			}),
+			expectedErrRegex: `found 3 anyOf subschema\(s\), schema composition is currently not supported`,
+		},
+		"too many oneOf": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"null"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"number"},
+					}),
+				},
+			}),
+			expectedErrRegex: `found 4 oneOf subschema\(s\), schema composition is currently not supported`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		errRegex := regexp.MustCompile(testCase.expectedErrRegex)
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if err == nil {
+				t.Fatalf("Expected err to match %q, got nil", testCase.expectedErrRegex)
+			}
+
+			if !errRegex.Match([]byte(err.Error())) {
+				t.Errorf("Expected error to match %q, got %q", testCase.expectedErrRegex, err.Error())
+			}
+		})
+	}
+}
+
+func TestBuildSchema_EdgeCases(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemaProxy        *base.SchemaProxy
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"no type with properties - defaults to object": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{},
+				Required: []string{"string", "object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey there! I'm a string type, required.",
+					}),
+					"object": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{},
+						Required:    []string{"bool"},
+						Description: "hey there! I'm an object type, required.",
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"bool": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"boolean"},
+								Description: "hey there! I'm a bool type, required.",
+							}),
+						}),
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "object",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceBoolAttribute{
+							Name: "bool",
+							BoolAttribute: resource.BoolAttribute{
+								ComputedOptionalRequired: schema.Required,
+								Description:              pointer("hey there! I'm a bool type, required."),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm an object type, required."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "string",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a string type, required."),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, err := schema.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchemaFromResponse_SchemaPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		op             *high.Operation
+		schemaOpts     oas.SchemaOpts
+		expectedSchema *oas.OASSchema
+	}{
+		"unwraps data envelope": {
+			op: createTestResponseOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"data": base.CreateSchemaProxy(&base.Schema{
+						Description: "this is the correct one!",
+						Type:        []string{"object"},
 					}),
+				}),
+			})),
+			schemaOpts: oas.SchemaOpts{
+				Ignores:    []string{"ignore_me"},
+				SchemaPath: []string{"data"},
+			},
+			expectedSchema: &oas.OASSchema{
+				Type: "object",
+				Schema: &base.Schema{
+					Description: "this is the correct one!",
+					Type:        []string{"object"},
 				},
-			}),
-			expectedErrRegex: `found 3 anyOf subschema\(s\), schema composition is currently not supported`,
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{"ignore_me"},
+				},
+			},
 		},
-		"too many oneOf": {
-			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				OneOf: []*base.SchemaProxy{
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"null"},
+		"unwraps nested envelope": {
+			op: createTestResponseOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"data": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"attributes": base.CreateSchemaProxy(&base.Schema{
+								Description: "this is the correct one!",
+								Type:        []string{"object"},
+							}),
+						}),
 					}),
-					base.CreateSchemaProxy(&base.Schema{
+				}),
+			})),
+			schemaOpts: oas.SchemaOpts{
+				SchemaPath: []string{"data", "attributes"},
+			},
+			expectedSchema: &oas.OASSchema{
+				Type: "object",
+				Schema: &base.Schema{
+					Description: "this is the correct one!",
+					Type:        []string{"object"},
+				},
+			},
+		},
+		"unwraps array envelope": {
+			op: createTestResponseOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"items": base.CreateSchemaProxy(&base.Schema{
+						Description: "this is the correct one!",
+						Type:        []string{"array"},
+					}),
+				}),
+			})),
+			schemaOpts: oas.SchemaOpts{
+				SchemaPath: []string{"items"},
+			},
+			expectedSchema: &oas.OASSchema{
+				Type: "array",
+				Schema: &base.Schema{
+					Description: "this is the correct one!",
+					Type:        []string{"array"},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := oas.BuildSchemaFromResponse(testCase.op, testCase.schemaOpts, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedSchema, cmpopts.IgnoreUnexported(base.Schema{}, oas.OASSchema{}, oas.GlobalSchemaOpts{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchemaFromResponse_SchemaPath_Errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		op               *high.Operation
+		schemaPath       []string
+		expectedErrRegex string
+	}{
+		"property not found": {
+			op: createTestResponseOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"data": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+					}),
+				}),
+			})),
+			schemaPath:       []string{"result"},
+			expectedErrRegex: `invalid schema path 'result', 'result' property not found`,
+		},
+		"not an object": {
+			op: createTestResponseOp(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"data": base.CreateSchemaProxy(&base.Schema{
 						Type: []string{"string"},
 					}),
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"integer"},
+				}),
+			})),
+			schemaPath:       []string{"data", "attributes"},
+			expectedErrRegex: `invalid schema path 'data.attributes', expected an object with a 'attributes' property`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		errRegex := regexp.MustCompile(testCase.expectedErrRegex)
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := oas.BuildSchemaFromResponse(testCase.op, oas.SchemaOpts{SchemaPath: testCase.schemaPath}, oas.GlobalSchemaOpts{})
+
+			if err == nil {
+				t.Fatalf("Expected err to match %q, got nil", testCase.expectedErrRegex)
+			}
+			if !errRegex.Match([]byte(err.Error())) {
+				t.Errorf("Expected error to match %q, got %q", testCase.expectedErrRegex, err.Error())
+			}
+		})
+	}
+}
+
+func createTestResponseOp(response *base.SchemaProxy) *high.Operation {
+	return &high.Operation{
+		Responses: &high.Responses{
+			Codes: orderedmap.ToOrderedMap(map[string]*high.Response{
+				"200": {
+					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+						"application/json": {
+							Schema: response,
+						},
 					}),
-					base.CreateSchemaProxy(&base.Schema{
-						Type: []string{"number"},
+				},
+			}),
+		},
+	}
+}
+
+func TestBuildSchemaFromResponse_Options(t *testing.T) {
+	t.Parallel()
+
+	op := &high.Operation{
+		Responses: &high.Responses{
+			Codes: orderedmap.ToOrderedMap(map[string]*high.Response{
+				"200": {
+					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+						"application/json": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this is the default 200 response!",
+								Type:        []string{"string"},
+							}),
+						},
+					}),
+				},
+				"202": {
+					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+						"application/json": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this is the 202 json response!",
+								Type:        []string{"object"},
+							}),
+						},
+						"text/plain": {
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Description: "this is the 202 text response!",
+								Type:        []string{"string"},
+							}),
+						},
 					}),
 				},
 			}),
-			expectedErrRegex: `found 4 oneOf subschema\(s\), schema composition is currently not supported`,
+		},
+	}
+
+	testCases := map[string]struct {
+		schemaOpts     oas.SchemaOpts
+		expectedSchema *oas.OASSchema
+	}{
+		"response code": {
+			schemaOpts: oas.SchemaOpts{
+				ResponseCode: "202",
+			},
+			expectedSchema: &oas.OASSchema{
+				Type: "object",
+				Schema: &base.Schema{
+					Description: "this is the 202 json response!",
+					Type:        []string{"object"},
+				},
+			},
+		},
+		"response code and media type": {
+			schemaOpts: oas.SchemaOpts{
+				ResponseCode: "202",
+				MediaType:    "text/plain",
+			},
+			expectedSchema: &oas.OASSchema{
+				Type: "string",
+				Schema: &base.Schema{
+					Description: "this is the 202 text response!",
+					Type:        []string{"string"},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := oas.BuildSchemaFromResponse(op, testCase.schemaOpts, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedSchema, cmpopts.IgnoreUnexported(base.Schema{}, oas.OASSchema{}, oas.GlobalSchemaOpts{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchemaFromResponse_Options_Errors(t *testing.T) {
+	t.Parallel()
+
+	op := createTestResponseOp(base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"string"},
+	}))
+
+	testCases := map[string]struct {
+		schemaOpts       oas.SchemaOpts
+		expectedErrRegex string
+	}{
+		"response code not found": {
+			schemaOpts: oas.SchemaOpts{
+				ResponseCode: "202",
+			},
+			expectedErrRegex: `response code '202' not found`,
+		},
+		"media type not found": {
+			schemaOpts: oas.SchemaOpts{
+				MediaType: "application/vnd.foo+json",
+			},
+			expectedErrRegex: `media type 'application/vnd.foo\+json' with a schema not found`,
 		},
 	}
 
@@ -1194,11 +2800,11 @@ func TestBuildSchema_Errors(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			_, err := oas.BuildSchemaFromResponse(op, testCase.schemaOpts, oas.GlobalSchemaOpts{})
+
 			if err == nil {
 				t.Fatalf("Expected err to match %q, got nil", testCase.expectedErrRegex)
 			}
-
 			if !errRegex.Match([]byte(err.Error())) {
 				t.Errorf("Expected error to match %q, got %q", testCase.expectedErrRegex, err.Error())
 			}
@@ -1206,60 +2812,71 @@ func TestBuildSchema_Errors(t *testing.T) {
 	}
 }
 
-func TestBuildSchema_EdgeCases(t *testing.T) {
+func TestBuildSchema_BestEffort(t *testing.T) {
 	t.Parallel()
 
-	testCases := map[string]struct {
-		schemaProxy        *base.SchemaProxy
-		expectedAttributes attrmapper.ResourceAttributes
-	}{
-		"no type with properties - defaults to object": {
-			schemaProxy: base.CreateSchemaProxy(&base.Schema{
-				Type:     []string{},
-				Required: []string{"string", "object"},
+	testSchema := &base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"items_missing": base.CreateSchemaProxy(&base.Schema{
+				Type:  []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{N: 1, B: true},
+			}),
+			"nested_obj": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
 				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
-					"string": base.CreateSchemaProxy(&base.Schema{
-						Type:        []string{"string"},
-						Description: "hey there! I'm a string type, required.",
+					"valid_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
 					}),
-					"object": base.CreateSchemaProxy(&base.Schema{
-						Type:        []string{},
-						Required:    []string{"bool"},
-						Description: "hey there! I'm an object type, required.",
-						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
-							"bool": base.CreateSchemaProxy(&base.Schema{
-								Type:        []string{"boolean"},
-								Description: "hey there! I'm a bool type, required.",
-							}),
-						}),
+					"!!!": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
 					}),
 				}),
 			}),
+		}),
+	}
+
+	testCases := map[string]struct {
+		bestEffort         bool
+		expectedAttributes attrmapper.ResourceAttributes
+		expectedWarnings   []string
+		expectedErr        string
+	}{
+		"best effort - unmappable properties are dropped": {
+			bestEffort: true,
 			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
 				&attrmapper.ResourceSingleNestedAttribute{
-					Name: "object",
+					Name: "nested_obj",
 					Attributes: attrmapper.ResourceAttributes{
-						&attrmapper.ResourceBoolAttribute{
-							Name: "bool",
-							BoolAttribute: resource.BoolAttribute{
-								ComputedOptionalRequired: schema.Required,
-								Description:              pointer("hey there! I'm a bool type, required."),
+						&attrmapper.ResourceStringAttribute{
+							Name: "valid_prop",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
 							},
 						},
 					},
 					SingleNestedAttribute: resource.SingleNestedAttribute{
-						ComputedOptionalRequired: schema.Required,
-						Description:              pointer("hey there! I'm an object type, required."),
-					},
-				},
-				&attrmapper.ResourceStringAttribute{
-					Name: "string",
-					StringAttribute: resource.StringAttribute{
-						ComputedOptionalRequired: schema.Required,
-						Description:              pointer("hey there! I'm a string type, required."),
+						ComputedOptionalRequired: schema.ComputedOptional,
 					},
 				},
 			},
+			expectedWarnings: []string{
+				"items_missing: invalid array items property, doesn't have a schema",
+				"nested_obj.!!!: '!!!' cannot be converted to a valid Terraform identifier",
+			},
+		},
+		"strict - first unmappable property returns an error": {
+			bestEffort:  false,
+			expectedErr: "invalid array items property, doesn't have a schema",
 		},
 	}
 
@@ -1269,19 +2886,45 @@ func TestBuildSchema_EdgeCases(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
-			if err != nil {
-				t.Fatalf("unexpected error: %s", err)
+			warnings := &oas.SchemaWarnings{}
+			globalOpts := oas.GlobalSchemaOpts{
+				OverrideComputability: schema.ComputedOptional,
+				Warnings:              warnings,
+				BestEffort:            testCase.bestEffort,
 			}
 
-			attributes, err := schema.BuildResourceAttributes()
+			s, err := oas.BuildSchema(base.CreateSchemaProxy(testSchema), oas.SchemaOpts{}, globalOpts)
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
 			}
 
+			attributes, schemaErr := s.BuildResourceAttributes()
+			if testCase.expectedErr != "" {
+				if schemaErr == nil {
+					t.Fatalf("expected error %q, got none", testCase.expectedErr)
+				}
+				if schemaErr.Error() != testCase.expectedErr {
+					t.Errorf("expected error %q, got %q", testCase.expectedErr, schemaErr.Error())
+				}
+				return
+			}
+			if schemaErr != nil {
+				t.Fatalf("unexpected error: %s", schemaErr)
+			}
+
 			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			var gotWarnings []string
+			for _, warning := range warnings.All() {
+				gotWarnings = append(gotWarnings, fmt.Sprintf("%s: %s", warning.Path(), warning.Error()))
+			}
+			sort.Strings(gotWarnings)
+
+			if diff := cmp.Diff(gotWarnings, testCase.expectedWarnings); diff != "" {
+				t.Errorf("unexpected difference in warnings: %s", diff)
+			}
 		})
 	}
 }
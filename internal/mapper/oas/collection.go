@@ -6,6 +6,7 @@ package oas
 import (
 	"errors"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
@@ -17,11 +18,13 @@ import (
 
 func (s *OASSchema) BuildCollectionResource(name string, computability schema.ComputedOptionalRequired) (attrmapper.ResourceAttribute, *SchemaError) {
 	if !s.Schema.Items.IsA() {
-		return nil, s.SchemaErrorFromProperty(errors.New("invalid array items property, doesn't have a schema"), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidArrayItems, errors.New("invalid array items property, doesn't have a schema")), name)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -29,7 +32,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 	}
 
 	// If the items schema is a map (i.e. additionalProperties set to a schema), it cannot be a NestedAttribute
-	if itemSchema.Type == util.OAS_type_object && !itemSchema.IsMap() {
+	if itemSchema.Type == util.OAS_type_object && !itemSchema.IsMap() && !itemSchema.IsJSONString() {
 		objectAttributes, err := itemSchema.BuildResourceAttributes()
 		if err != nil {
 			return nil, s.NestSchemaError(err, name)
@@ -45,6 +48,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 					ComputedOptionalRequired: computability,
 					DeprecationMessage:       s.GetDeprecationMessage(),
 					Description:              s.GetDescription(),
+					Sensitive:                s.IsSensitive(),
 				},
 			}
 
@@ -64,6 +68,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -87,6 +92,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -104,6 +110,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -116,11 +123,13 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 
 func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
 	if !s.Schema.Items.IsA() {
-		return nil, s.SchemaErrorFromProperty(errors.New("invalid array items property, doesn't have a schema"), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidArrayItems, errors.New("invalid array items property, doesn't have a schema")), name)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -128,7 +137,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 	}
 
 	// If the items schema is a map (i.e. additionalProperties set to a schema), it cannot be a NestedAttribute
-	if itemSchema.Type == util.OAS_type_object && !itemSchema.IsMap() {
+	if itemSchema.Type == util.OAS_type_object && !itemSchema.IsMap() && !itemSchema.IsJSONString() {
 		objectAttributes, err := itemSchema.BuildDataSourceAttributes()
 		if err != nil {
 			return nil, s.NestSchemaError(err, name)
@@ -145,6 +154,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 					ComputedOptionalRequired: computability,
 					DeprecationMessage:       s.GetDeprecationMessage(),
 					Description:              s.GetDescription(),
+					Sensitive:                s.IsSensitive(),
 				},
 			}
 
@@ -164,6 +174,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -188,6 +199,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -205,6 +217,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -217,11 +230,13 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 
 func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
 	if !s.Schema.Items.IsA() {
-		return nil, s.SchemaErrorFromProperty(errors.New("invalid array items property, doesn't have a schema"), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidArrayItems, errors.New("invalid array items property, doesn't have a schema")), name)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -229,7 +244,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 	}
 
 	// If the items schema is a map (i.e. additionalProperties set to a schema), it cannot be a NestedAttribute
-	if itemSchema.Type == util.OAS_type_object && !itemSchema.IsMap() {
+	if itemSchema.Type == util.OAS_type_object && !itemSchema.IsMap() && !itemSchema.IsJSONString() {
 		objectAttributes, err := itemSchema.BuildProviderAttributes()
 		if err != nil {
 			return nil, s.NestSchemaError(err, name)
@@ -246,6 +261,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 					OptionalRequired:   optionalOrRequired,
 					DeprecationMessage: s.GetDeprecationMessage(),
 					Description:        s.GetDescription(),
+					Sensitive:          s.IsSensitive(),
 					Validators:         s.GetSetValidators(),
 				},
 			}
@@ -262,6 +278,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetListValidators(),
 			},
 		}
@@ -282,6 +299,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetSetValidators(),
 			},
 		}
@@ -296,6 +314,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetListValidators(),
 		},
 	}
@@ -305,11 +324,13 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 
 func (s *OASSchema) BuildCollectionElementType() (schema.ElementType, *SchemaError) {
 	if !s.Schema.Items.IsA() {
-		return schema.ElementType{}, SchemaErrorFromNode(errors.New("invalid array type for nested elem array, doesn't have a schema"), s.Schema, Items)
+		return schema.ElementType{}, SchemaErrorFromNode(diagnostic.WithCode(diagnostic.CodeInvalidArrayItems, errors.New("invalid array type for nested elem array, doesn't have a schema")), s.Schema, Items)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
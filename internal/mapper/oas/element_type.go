@@ -6,11 +6,16 @@ package oas
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 func (s *OASSchema) BuildElementType() (schema.ElementType, *SchemaError) {
+	if s.IsJSONString() {
+		return s.BuildJSONStringElementType()
+	}
+
 	switch s.Type {
 	case util.OAS_type_string:
 		return s.BuildStringElementType()
@@ -29,6 +34,6 @@ func (s *OASSchema) BuildElementType() (schema.ElementType, *SchemaError) {
 		return s.BuildObjectElementType()
 
 	default:
-		return schema.ElementType{}, SchemaErrorFromNode(fmt.Errorf("invalid schema type '%s'", s.Type), s.Schema, Type)
+		return schema.ElementType{}, SchemaErrorFromNode(diagnostic.WithCode(diagnostic.CodeInvalidSchemaType, fmt.Errorf("invalid schema type '%s'", s.Type)), s.Schema, Type)
 	}
 }
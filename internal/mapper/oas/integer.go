@@ -19,6 +19,7 @@ func (s *OASSchema) BuildIntegerResource(name string, computability schema.Compu
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -49,6 +50,7 @@ func (s *OASSchema) BuildIntegerDataSource(name string, computability schema.Com
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -66,6 +68,7 @@ func (s *OASSchema) BuildIntegerProvider(name string, optionalOrRequired schema.
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetIntegerValidators(),
 		},
 	}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// kubernetesPreserveUnknownFields is the Kubernetes extension that marks an object as accepting any fields, which are not described by the schema.
+const kubernetesPreserveUnknownFields = "x-kubernetes-preserve-unknown-fields"
+
+// IsJSONString determines if the schema should be mapped to a string attribute that holds normalized JSON, which is either forced with
+// SchemaOpts.ForceJSONString or when the schema is a free-form object.
+func (s *OASSchema) IsJSONString() bool {
+	return s.SchemaOpts.ForceJSONString || s.IsFreeForm()
+}
+
+// IsFreeForm determines if the schema is an object that can't be represented with nested attributes, because the properties
+// of the object aren't described by the schema. This includes objects without any properties, objects with `additionalProperties: true`,
+// and objects with the `x-kubernetes-preserve-unknown-fields` extension enabled.
+func (s *OASSchema) IsFreeForm() bool {
+	if s.Type != util.OAS_type_object {
+		return false
+	}
+
+	if hasPreserveUnknownFields(s.Schema) {
+		return true
+	}
+
+	if s.Schema.AdditionalProperties != nil && s.Schema.AdditionalProperties.IsB() && s.Schema.AdditionalProperties.B {
+		return true
+	}
+
+	if s.IsMap() {
+		return false
+	}
+
+	return s.Schema.Properties == nil || s.Schema.Properties.Len() == 0
+}
+
+// hasPreserveUnknownFields checks if the `x-kubernetes-preserve-unknown-fields` extension is enabled for a schema.
+func hasPreserveUnknownFields(s *base.Schema) bool {
+	if s.Extensions == nil {
+		return false
+	}
+
+	extension, ok := s.Extensions.Get(kubernetesPreserveUnknownFields)
+	if !ok || extension == nil {
+		return false
+	}
+
+	var enabled bool
+	if err := extension.Decode(&enabled); err != nil {
+		return false
+	}
+
+	return enabled
+}
+
+// IsPropertyJSONString checks if a property has been forced to be mapped as a JSON string, recording the match in
+// GlobalSchemaOpts.Matches
+func (s *OASSchema) IsPropertyJSONString(name string) bool {
+	for _, jsonString := range s.SchemaOpts.JSONStrings {
+		if name == jsonString {
+			s.GlobalSchemaOpts.Matches.addOverride(s.GlobalSchemaOpts.attributePath, name)
+			return true
+		}
+	}
+	return false
+}
+
+// GetJSONStringsForNested is a helper function that will return all nested JSON string paths for a property. If no JSON string
+// paths or nested JSON string paths are found, returns an empty string slice.
+func (s *OASSchema) GetJSONStringsForNested(name string) []string {
+	newJSONStrings := make([]string, 0)
+
+	for _, jsonString := range s.SchemaOpts.JSONStrings {
+		jsonStringParts := strings.Split(jsonString, ".")
+
+		if len(jsonStringParts) > 1 && name == jsonStringParts[0] {
+			newJSONString := strings.Join(jsonStringParts[1:], ".")
+
+			if newJSONString != "" {
+				newJSONStrings = append(newJSONStrings, newJSONString)
+			}
+		}
+	}
+
+	return newJSONStrings
+}
+
+func (s *OASSchema) BuildJSONStringResource(name string, computability schema.ComputedOptionalRequired) (attrmapper.ResourceAttribute, *SchemaError) {
+	result := &attrmapper.ResourceStringAttribute{
+		Name: name,
+		StringAttribute: resource.StringAttribute{
+			ComputedOptionalRequired: computability,
+			CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+			DeprecationMessage:       s.GetDeprecationMessage(),
+			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
+		},
+	}
+
+	return result, nil
+}
+
+func (s *OASSchema) BuildJSONStringDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
+	result := &attrmapper.DataSourceStringAttribute{
+		Name: name,
+		StringAttribute: datasource.StringAttribute{
+			ComputedOptionalRequired: computability,
+			CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+			DeprecationMessage:       s.GetDeprecationMessage(),
+			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
+		},
+	}
+
+	return result, nil
+}
+
+func (s *OASSchema) BuildJSONStringProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
+	result := &attrmapper.ProviderStringAttribute{
+		Name: name,
+		StringAttribute: provider.StringAttribute{
+			OptionalRequired:   optionalOrRequired,
+			CustomType:         frameworkcustomtypes.JSONTypesNormalized(),
+			DeprecationMessage: s.GetDeprecationMessage(),
+			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+		},
+	}
+
+	return result, nil
+}
+
+func (s *OASSchema) BuildJSONStringElementType() (schema.ElementType, *SchemaError) {
+	return schema.ElementType{
+		String: &schema.StringType{
+			CustomType: frameworkcustomtypes.JSONTypesNormalized(),
+		},
+	}, nil
+}
@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkcustomtypes"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+func TestBuildJSONStringResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema             *base.Schema
+		schemaOpts         oas.SchemaOpts
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"free-form objects": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"no_properties_required"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"no_properties_required": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey there! I'm an object without properties, required.",
+					}),
+					"additional_properties": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey there! I'm an object with additional properties.",
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"known_prop": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+						AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+							N: 1,
+							B: true,
+						},
+					}),
+					"preserve_unknown_fields": base.CreateSchemaProxy(&base.Schema{
+						Description: "hey there! I'm an object that preserves unknown fields.",
+						Extensions: orderedmap.ToOrderedMap(map[string]*yaml.Node{
+							"x-kubernetes-preserve-unknown-fields": {Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"},
+						}),
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "additional_properties",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an object with additional properties."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "no_properties_required",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an object without properties, required."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "preserve_unknown_fields",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an object that preserves unknown fields."),
+					},
+				},
+			},
+		},
+		"free-form object elements": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"list_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"array"},
+						Description: "hey there! I'm a list of free-form objects.",
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+							}),
+						},
+					}),
+					"map_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey there! I'm a map of free-form objects.",
+						AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+							}),
+						},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListAttribute{
+					Name: "list_prop",
+					ListAttribute: resource.ListAttribute{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{
+								CustomType: frameworkcustomtypes.JSONTypesNormalized(),
+							},
+						},
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a list of free-form objects."),
+					},
+				},
+				&attrmapper.ResourceMapAttribute{
+					Name: "map_prop",
+					MapAttribute: resource.MapAttribute{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{
+								CustomType: frameworkcustomtypes.JSONTypesNormalized(),
+							},
+						},
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm a map of free-form objects."),
+					},
+				},
+			},
+		},
+		"forced JSON strings": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"forced_obj": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey there! I'm an object forced to JSON.",
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"bool_prop": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"boolean"},
+							}),
+						}),
+					}),
+					"nested_obj": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"forced_list": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"array"},
+								Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+									A: base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								},
+							}),
+						}),
+					}),
+				}),
+			},
+			schemaOpts: oas.SchemaOpts{
+				JSONStrings: []string{"forced_obj", "nested_obj.forced_list"},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "forced_obj",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an object forced to JSON."),
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "nested_obj",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "forced_list",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := oas.OASSchema{Schema: testCase.schema, SchemaOpts: testCase.schemaOpts}
+			attributes, err := schema.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildJSONStringDataSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema             *base.Schema
+		schemaOpts         oas.SchemaOpts
+		expectedAttributes attrmapper.DataSourceAttributes
+	}{
+		"free-form object": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"no_properties_required"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"no_properties_required": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey there! I'm an object without properties, required.",
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceStringAttribute{
+					Name: "no_properties_required",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an object without properties, required."),
+					},
+				},
+			},
+		},
+		"forced JSON string": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"forced_int": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Description: "hey there! I'm an integer forced to JSON.",
+					}),
+				}),
+			},
+			schemaOpts: oas.SchemaOpts{
+				JSONStrings: []string{"forced_int"},
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceStringAttribute{
+					Name: "forced_int",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						CustomType:               frameworkcustomtypes.JSONTypesNormalized(),
+						Description:              pointer("hey there! I'm an integer forced to JSON."),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := oas.OASSchema{Schema: testCase.schema, SchemaOpts: testCase.schemaOpts}
+			attributes, err := schema.BuildDataSourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildJSONStringProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema             *base.Schema
+		expectedAttributes attrmapper.ProviderAttributes
+	}{
+		"free-form object": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"no_properties": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey there! I'm an object without properties.",
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ProviderAttributes{
+				&attrmapper.ProviderStringAttribute{
+					Name: "no_properties",
+					StringAttribute: provider.StringAttribute{
+						OptionalRequired: schema.Optional,
+						CustomType:       frameworkcustomtypes.JSONTypesNormalized(),
+						Description:      pointer("hey there! I'm an object without properties."),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := oas.OASSchema{Schema: testCase.schema}
+			attributes, err := schema.BuildProviderAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package oas
 import (
 	"errors"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
@@ -19,18 +20,20 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 	// Maps are detected as `type: object`, with an `additionalProperties` field that is a schema. `additionalProperties` can
 	// also be a boolean (which we should ignore and map to an SingleNestedAttribute), so calling functions should call s.IsMap() first.
 	if !s.IsMap() {
-		return nil, s.SchemaErrorFromProperty(errors.New("invalid map, additionalProperties doesn't have a valid schema"), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidMap, errors.New("invalid map, additionalProperties doesn't have a valid schema")), name)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	if mapSchema.Type == util.OAS_type_object {
+	if mapSchema.Type == util.OAS_type_object && !mapSchema.IsJSONString() {
 		mapAttributes, err := mapSchema.BuildResourceAttributes()
 		if err != nil {
 			return nil, s.NestSchemaError(err, name)
@@ -44,6 +47,7 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -66,6 +70,7 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -80,18 +85,20 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 	// Maps are detected as `type: object`, with an `additionalProperties` field that is a schema. `additionalProperties` can
 	// also be a boolean (which we should ignore and map to an SingleNestedAttribute), so calling functions should call s.IsMap() first.
 	if !s.IsMap() {
-		return nil, s.SchemaErrorFromProperty(errors.New("invalid map, additionalProperties doesn't have a valid schema"), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidMap, errors.New("invalid map, additionalProperties doesn't have a valid schema")), name)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	if mapSchema.Type == util.OAS_type_object {
+	if mapSchema.Type == util.OAS_type_object && !mapSchema.IsJSONString() {
 		mapAttributes, err := mapSchema.BuildDataSourceAttributes()
 		if err != nil {
 			return nil, s.NestSchemaError(err, name)
@@ -106,6 +113,7 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -128,6 +136,7 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -142,18 +151,20 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 	// Maps are detected as `type: object`, with an `additionalProperties` field that is a schema. `additionalProperties` can
 	// also be a boolean (which we should ignore and map to an SingleNestedAttribute), so calling functions should call s.IsMap() first.
 	if !s.IsMap() {
-		return nil, s.SchemaErrorFromProperty(errors.New("invalid map, additionalProperties doesn't have a valid schema"), name)
+		return nil, s.SchemaErrorFromProperty(diagnostic.WithCode(diagnostic.CodeInvalidMap, errors.New("invalid map, additionalProperties doesn't have a valid schema")), name)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	if mapSchema.Type == util.OAS_type_object {
+	if mapSchema.Type == util.OAS_type_object && !mapSchema.IsJSONString() {
 		mapAttributes, err := mapSchema.BuildProviderAttributes()
 		if err != nil {
 			return nil, s.NestSchemaError(err, name)
@@ -168,6 +179,7 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetMapValidators(),
 			},
 		}
@@ -187,6 +199,7 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 			Validators:         s.GetMapValidators(),
 		},
 	}
@@ -198,11 +211,13 @@ func (s *OASSchema) BuildMapElementType() (schema.ElementType, *SchemaError) {
 	// Maps are detected as `type: object`, with an `additionalProperties` field that is a schema. `additionalProperties` can
 	// also be a boolean (which we should ignore and map to an ObjectType), so calling functions should call s.IsMap() first.
 	if !s.IsMap() {
-		return schema.ElementType{}, SchemaErrorFromNode(errors.New("invalid map, additionalProperties doesn't have a valid schema"), s.Schema, AdditionalProperties)
+		return schema.ElementType{}, SchemaErrorFromNode(diagnostic.WithCode(diagnostic.CodeInvalidMap, errors.New("invalid map, additionalProperties doesn't have a valid schema")), s.Schema, AdditionalProperties)
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Ignores:             s.SchemaOpts.Ignores,
+		JSONStrings:         s.SchemaOpts.JSONStrings,
+		MultiTypeStrategies: s.SchemaOpts.MultiTypeStrategies,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
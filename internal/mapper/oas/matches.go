@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"slices"
+	"strings"
+)
+
+// OptionMatches records the absolute property paths of ignores and overrides that matched a property while building schemas, which
+// is used to report ignores and overrides that don't match anything. Overrides are only recorded for the options that are applied
+// while building schemas, JSON strings and multi-type strategies. A nil OptionMatches doesn't record anything.
+type OptionMatches struct {
+	ignores   map[string]bool
+	overrides map[string]bool
+}
+
+// NewOptionMatches returns an empty OptionMatches.
+func NewOptionMatches() *OptionMatches {
+	return &OptionMatches{
+		ignores:   make(map[string]bool),
+		overrides: make(map[string]bool),
+	}
+}
+
+// IgnoreMatched returns true if the ignore, like `spec.containers`, matched a property.
+func (m *OptionMatches) IgnoreMatched(ignore string) bool {
+	if m == nil {
+		return false
+	}
+
+	return m.ignores[ignore]
+}
+
+// OverrideMatched returns true if the JSON string or multi-type strategy of the override, like `spec.containers`, matched a property.
+func (m *OptionMatches) OverrideMatched(key string) bool {
+	if m == nil {
+		return false
+	}
+
+	return m.overrides[key]
+}
+
+func (m *OptionMatches) addIgnore(parentPath []string, name string) {
+	if m == nil {
+		return
+	}
+
+	m.ignores[matchPath(parentPath, name)] = true
+}
+
+func (m *OptionMatches) addOverride(parentPath []string, name string) {
+	if m == nil {
+		return
+	}
+
+	m.overrides[matchPath(parentPath, name)] = true
+}
+
+func matchPath(parentPath []string, name string) string {
+	return strings.Join(append(slices.Clone(parentPath), name), ".")
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+const (
+	// MultiTypeStrategyJSON will map an unsupported multi-type to a normalized JSON string attribute
+	MultiTypeStrategyJSON = "json"
+	// MultiTypeStrategyWidest will map an unsupported multi-type to the widest type that can represent all of the types, falling
+	// back to a normalized JSON string attribute if there is no such type
+	MultiTypeStrategyWidest = "widest"
+	// MultiTypeStrategyDrop will not map an unsupported multi-type, and will add a warning
+	MultiTypeStrategyDrop = "drop"
+)
+
+// buildMultiTypeFallback will build a schema that returned an ErrMultiTypeSchema error with the multi-type strategy in GlobalSchemaOpts.
+// If the error is not an ErrMultiTypeSchema error, or the strategy doesn't build a schema (like MultiTypeStrategyDrop), the original error
+// is returned.
+func buildMultiTypeFallback(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts GlobalSchemaOpts, multiTypeErr *SchemaError) (*OASSchema, *SchemaError) {
+	strategy := globalOpts.MultiTypeStrategy
+	if !errors.Is(multiTypeErr, ErrMultiTypeSchema) || (strategy != MultiTypeStrategyJSON && strategy != MultiTypeStrategyWidest) {
+		return nil, multiTypeErr
+	}
+
+	s, err := proxy.BuildSchema()
+	if err != nil {
+		return nil, multiTypeErr
+	}
+
+	if strategy == MultiTypeStrategyWidest {
+		widest := widestSchema(s)
+		if widest != nil {
+			return &OASSchema{
+				Type:             widest.Type[0],
+				Format:           widest.Format,
+				Schema:           widest,
+				SchemaOpts:       schemaOpts,
+				GlobalSchemaOpts: globalOpts,
+			}, nil
+		}
+	}
+
+	// A JSON string can represent all of the types
+	schemaOpts.ForceJSONString = true
+
+	return &OASSchema{
+		Type:             util.OAS_type_string,
+		Schema:           s,
+		SchemaOpts:       schemaOpts,
+		GlobalSchemaOpts: globalOpts,
+	}, nil
+}
+
+// widestSchema returns a schema with the widest type that can represent all of the non-null types of a multi-type schema. Returns nil if there
+// is no such type.
+//   - Primitive types are widened from integer, to number, to string. A boolean type combined with any other primitive type is widened to string.
+//   - An array type combined with primitive types that are widened by the type of the array items is widened to the array, as a single value can
+//     be represented as an array with one item.
+//   - Any other combination, like object types or multiple array types, can't be widened.
+func widestSchema(s *base.Schema) *base.Schema {
+	members := multiTypeMembers(s)
+	if len(members) == 0 {
+		return nil
+	}
+
+	var arraySchema *base.Schema
+	primitiveTypes := []string{}
+	for _, member := range members {
+		memberType := member.Type[0]
+
+		switch memberType {
+		case util.OAS_type_boolean, util.OAS_type_integer, util.OAS_type_number, util.OAS_type_string:
+			primitiveTypes = append(primitiveTypes, memberType)
+		case util.OAS_type_array:
+			if arraySchema != nil {
+				return nil
+			}
+			arraySchema = member
+		default:
+			return nil
+		}
+	}
+
+	if arraySchema != nil {
+		if arraySchema.Items == nil || !arraySchema.Items.IsA() {
+			return nil
+		}
+
+		itemSchema, err := buildSchemaProxy(arraySchema.Items.A)
+		if err != nil {
+			return nil
+		}
+
+		itemType, err := retrieveType(itemSchema)
+		if err != nil {
+			return nil
+		}
+
+		for _, primitiveType := range primitiveTypes {
+			if !isWidenedBy(itemType, primitiveType) {
+				return nil
+			}
+		}
+
+		widest := *arraySchema
+		if widest.Description == "" {
+			widest.Description = s.Description
+		}
+
+		return &widest
+	}
+
+	widestType := primitiveTypes[0]
+	for _, primitiveType := range primitiveTypes[1:] {
+		switch {
+		case isWidenedBy(widestType, primitiveType):
+		case isWidenedBy(primitiveType, widestType):
+			widestType = primitiveType
+		default:
+			widestType = util.OAS_type_string
+		}
+	}
+
+	widest := *s
+	widest.Type = []string{widestType}
+	widest.Format = ""
+	widest.AnyOf = nil
+	widest.OneOf = nil
+
+	return &widest
+}
+
+// isWidenedBy determines if a value of type t can be represented by the wide type.
+func isWidenedBy(wide string, t string) bool {
+	switch {
+	case wide == t:
+		return true
+	case wide == util.OAS_type_number && t == util.OAS_type_integer:
+		return true
+	case wide == util.OAS_type_string && isStringableType(t):
+		return true
+	default:
+		return false
+	}
+}
+
+// multiTypeMembers returns a schema for each non-null type of a multi-type schema, which is either a "type" array or anyOf/oneOf subschemas.
+// Returns nil if the type of any member can't be determined.
+func multiTypeMembers(s *base.Schema) []*base.Schema {
+	members := []*base.Schema{}
+
+	proxies := s.AnyOf
+	if len(proxies) == 0 {
+		proxies = s.OneOf
+	}
+
+	if len(proxies) == 0 {
+		for _, t := range s.Type {
+			if t == util.OAS_type_null {
+				continue
+			}
+
+			member := *s
+			member.Type = []string{t}
+			members = append(members, &member)
+		}
+
+		return members
+	}
+
+	for _, proxy := range proxies {
+		member, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return nil
+		}
+
+		memberType, err := retrieveType(member)
+		if err != nil {
+			return nil
+		}
+
+		if memberType == util.OAS_type_null {
+			continue
+		}
+
+		member.Type = []string{memberType}
+		members = append(members, member)
+	}
+
+	return members
+}
+
+// dropMultiTypeProperty will drop a property that returned an ErrMultiTypeSchema error if the multi-type strategy is MultiTypeStrategyDrop,
+// adding the error as a warning. Returns true if the property was dropped.
+func (g GlobalSchemaOpts) dropMultiTypeProperty(err *SchemaError) bool {
+	if g.MultiTypeStrategy != MultiTypeStrategyDrop || !errors.Is(err, ErrMultiTypeSchema) {
+		return false
+	}
+
+	g.Warnings.Add(err.withPath(g.attributePath...))
+
+	return true
+}
+
+// GetMultiTypeStrategiesForNested is a helper function that will return all nested multi-type strategies for a property, with the property
+// name removed from the path. If no nested multi-type strategies are found, returns an empty map.
+func (s *OASSchema) GetMultiTypeStrategiesForNested(name string) map[string]string {
+	newStrategies := make(map[string]string)
+
+	for path, strategy := range s.SchemaOpts.MultiTypeStrategies {
+		pathParts := strings.Split(path, ".")
+
+		if len(pathParts) > 1 && name == pathParts[0] {
+			newPath := strings.Join(pathParts[1:], ".")
+
+			if newPath != "" {
+				newStrategies[newPath] = strategy
+			}
+		}
+	}
+
+	return newStrategies
+}
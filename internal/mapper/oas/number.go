@@ -21,6 +21,7 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -50,6 +51,7 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}, nil
 }
@@ -62,6 +64,7 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 				ComputedOptionalRequired: computability,
 				DeprecationMessage:       s.GetDeprecationMessage(),
 				Description:              s.GetDescription(),
+				Sensitive:                s.IsSensitive(),
 			},
 		}
 
@@ -77,6 +80,7 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
 	}
 
@@ -91,6 +95,7 @@ func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.O
 				OptionalRequired:   optionalOrRequired,
 				DeprecationMessage: s.GetDeprecationMessage(),
 				Description:        s.GetDescription(),
+				Sensitive:          s.IsSensitive(),
 				Validators:         s.GetFloatValidators(),
 			},
 		}
@@ -103,6 +108,7 @@ func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.O
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
 		},
 	}
 
@@ -5,6 +5,7 @@ package oas
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
@@ -14,6 +15,9 @@ import (
 	"github.com/pb33f/libopenapi/orderedmap"
 )
 
+// writeOnlyDescription is appended to the description of write-only attributes.
+const writeOnlyDescription = "This attribute is write-only and is not returned by the API."
+
 type OASSchema struct {
 	Type   string
 	Format string
@@ -31,6 +35,35 @@ type GlobalSchemaOpts struct {
 	// create request for a resource, does not become required from a lower precedence operation, such as an
 	// read response for a resource.
 	OverrideComputability schema.ComputedOptionalRequired
+
+	// InferComputability will map optional properties without a default value as Optional, instead of ComputedOptional. This is
+	// used for request bodies of resources, where these attributes are changed back to ComputedOptional when merged with a
+	// response attribute, so they are only Optional if their value will only ever be set by configuration. Refer to
+	// GetResourceComputability for all rules.
+	InferComputability bool
+
+	// RecursionOpts control how recursive schemas that exceed a max depth are mapped.
+	RecursionOpts RecursionOpts
+
+	// MultiTypeStrategy determines how unsupported multi-types are mapped, either MultiTypeStrategyJSON, MultiTypeStrategyWidest,
+	// or MultiTypeStrategyDrop. If empty, unsupported multi-types will return an ErrMultiTypeSchema error.
+	MultiTypeStrategy string
+
+	// Warnings collects warnings for the entire schema, such as recursive properties that were dropped.
+	Warnings *SchemaWarnings
+
+	// BestEffort will drop a property (and all of it's nested properties) that can't be mapped, adding the error to Warnings, instead
+	// of returning the error and failing to build the entire schema.
+	BestEffort bool
+
+	// Matches records the ignores and overrides that matched a property, if set.
+	Matches *OptionMatches
+
+	// recursionStack contains the identities of all parent schemas, which is used to detect recursive schemas.
+	recursionStack []string
+
+	// attributePath contains the property names of all parent schemas, which is used to create warnings with an absolute path.
+	attributePath []string
 }
 
 // SchemaOpts is NOT passed recursively through built OASSchema structs, and will only be available to the top level schema. This is used
@@ -39,6 +72,18 @@ type SchemaOpts struct {
 	// Ignores contains all potentially relevant ignores for a schema and it's potential nested schemas
 	Ignores []string
 
+	// JSONStrings contains all potentially relevant paths of properties that will be mapped as JSON strings, for a schema and it's
+	// potential nested schemas
+	JSONStrings []string
+
+	// ForceJSONString will map the schema to a string attribute that holds normalized JSON, regardless of the schema type.
+	ForceJSONString bool
+
+	// MultiTypeStrategies contains all potentially relevant multi-type strategies for a schema and it's potential nested schemas, with
+	// the key being the property path. A multi-type strategy for a property will override GlobalSchemaOpts.MultiTypeStrategy for the
+	// property and it's nested schemas.
+	MultiTypeStrategies map[string]string
+
 	// OverrideDeprecationMessage will set the attribute deprecation message to
 	// this field if populated, otherwise the attribute deprecation message will
 	// be set to a default "This attribute is deprecated." message when the
@@ -48,6 +93,23 @@ type SchemaOpts struct {
 	// OverrideDescription will set the attribute description to this field if populated, otherwise the attribute description
 	// will be set to the description field of the `schema`.
 	OverrideDescription string
+
+	// ExactlyOneOf contains the Terraform identifiers of sibling attributes that are mutually exclusive with this attribute. This is
+	// populated for oneOf/anyOf variants, which will have an ExactlyOneOf validator when the attribute is configurable.
+	ExactlyOneOf []string
+
+	// SchemaPath is a list of property names, used to select a nested schema of a request or response body to build instead of the
+	// body schema itself. This is used to unwrap envelopes, like `{"data": {...}}`, and is only used by BuildSchemaFromRequest and
+	// BuildSchemaFromResponse.
+	SchemaPath []string
+
+	// ResponseCode will select the response body to build by response code, instead of the default response code order. This is
+	// only used by BuildSchemaFromResponse.
+	ResponseCode string
+
+	// MediaType will select the request or response body to build by media type, instead of the default media type order. This is
+	// only used by BuildSchemaFromRequest and BuildSchemaFromResponse.
+	MediaType string
 }
 
 // IsMap checks the `additionalProperties` field to determine if a map type is appropriate (refer to [JSON Schema - additionalProperties]).
@@ -59,34 +121,131 @@ func (s *OASSchema) IsMap() bool {
 
 // SchemaErrorFromProperty is a helper function for creating an SchemaError struct for a property.
 func (s *OASSchema) SchemaErrorFromProperty(err error, propName string) *SchemaError {
-	return NewSchemaError(err, s.getPropertyLineNumber(propName), propName)
+	return newSchemaErrorFromLocation(err, s.getPropertyLocation(propName), propName)
 }
 
 // NestSchemaError is a helper function for creating a nested SchemaError struct for a property.
 func (s *OASSchema) NestSchemaError(err *SchemaError, propName string) *SchemaError {
-	return err.NestedSchemaError(propName, s.getPropertyLineNumber(propName))
+	return err.nestedSchemaError(propName, s.getPropertyLocation(propName))
 }
 
 // getPropertyLineNumber looks in the low-level schema instance for line information. Returns 0 if not found.
 func (s *OASSchema) getPropertyLineNumber(propName string) int {
+	return s.getPropertyLocation(propName).line()
+}
+
+// getPropertyLocation looks in the low-level schema instance for the source location of a property. Returns nil if not found.
+func (s *OASSchema) getPropertyLocation(propName string) *sourceLocation {
 	low := s.Schema.GoLow()
 	if low == nil {
-		return 0
+		return nil
 	}
 
-	// Check property nodes first for a line number
+	// Check property nodes first for a location
 	for pair := range orderedmap.Iterate(context.TODO(), low.Properties.Value) {
 		if pair.Key().Value == propName {
-			return pair.Value().NodeLineNumber()
+			return newSourceLocation(pair.Value().ValueNode, low.Index)
 		}
 	}
 
-	// If it's not found in properties, default to the line number from the parent node
+	// If it's not found in properties, default to the location of the parent node
 	if low.ParentProxy != nil && low.ParentProxy.GetValueNode() != nil {
-		return low.ParentProxy.GetValueNode().Line
+		return &sourceLocation{
+			node:  low.ParentProxy.GetValueNode(),
+			proxy: low.ParentProxy,
+		}
 	}
 
-	return 0
+	return nil
+}
+
+// LookupPropertyLineNumber looks in the low-level schema instance for line information of a nested property, following the items of
+// arrays and the additionalProperties of maps like attribute paths do. Returns false if the property is not found, and a line number
+// of 0 if no line information exists for the property.
+func (s *OASSchema) LookupPropertyLineNumber(path []string) (int, bool) {
+	current := s.Schema
+	for i, propName := range path {
+		if current == nil {
+			return 0, false
+		}
+
+		if current.Items != nil && current.Items.IsA() {
+			current = current.Items.A.Schema()
+		} else if current.AdditionalProperties != nil && current.AdditionalProperties.IsA() {
+			current = current.AdditionalProperties.A.Schema()
+		}
+
+		if current == nil || current.Properties == nil {
+			return 0, false
+		}
+
+		propProxy, ok := current.Properties.Get(propName)
+		if !ok || propProxy == nil {
+			return 0, false
+		}
+
+		if i == len(path)-1 {
+			return (&OASSchema{Schema: current}).getPropertyLineNumber(propName), true
+		}
+
+		current = propProxy.Schema()
+	}
+
+	return 0, false
+}
+
+// maxPropertySearchDepth limits how many nested array items and map values are searched for the next property of a path by
+// HasProperty, which prevents endless searches of recursive schemas, like an array of arrays that refers to itself.
+const maxPropertySearchDepth = 16
+
+// HasProperty returns true if the schema has a nested property at path, following the items of arrays and the additionalProperties
+// of maps like attribute paths do. Schema composition keywords are resolved like they are when building attributes, so the properties
+// of allOf subschemas are merged, and oneOf/anyOf variants are properties. This is used to check that ignores, which use OAS property
+// names, exist.
+func (s *OASSchema) HasProperty(path []string) bool {
+	return hasProperty(s.Schema, path, 0)
+}
+
+func hasProperty(s *base.Schema, path []string, depth int) bool {
+	if s == nil || depth > maxPropertySearchDepth {
+		return false
+	}
+
+	if len(path) == 0 {
+		return true
+	}
+
+	if s.Properties != nil {
+		propProxy, ok := s.Properties.Get(path[0])
+		if ok && propProxy != nil && (len(path) == 1 || hasProperty(resolveSchemaProxy(propProxy), path[1:], 0)) {
+			return true
+		}
+	}
+
+	// The properties of array items and map values don't include the name of the array or map in their path
+	if s.Items != nil && s.Items.IsA() && hasProperty(resolveSchemaProxy(s.Items.A), path, depth+1) {
+		return true
+	}
+	if s.AdditionalProperties != nil && s.AdditionalProperties.IsA() && hasProperty(resolveSchemaProxy(s.AdditionalProperties.A), path, depth+1) {
+		return true
+	}
+
+	return false
+}
+
+// resolveSchemaProxy builds a schema proxy and resolves any schema composition keywords, falling back to the schema without
+// composition resolved if that fails.
+func resolveSchemaProxy(proxy *base.SchemaProxy) *base.Schema {
+	if proxy == nil {
+		return nil
+	}
+
+	s, err := buildSchemaProxy(proxy)
+	if err != nil {
+		return proxy.Schema()
+	}
+
+	return s
 }
 
 // GetDeprecationMessage returns a deprecation message if the deprecated
@@ -106,20 +265,40 @@ func (s *OASSchema) GetDeprecationMessage() *string {
 	return &deprecationMessage
 }
 
+// GetDescription returns the description of the schema, unless SchemaOpts.OverrideDescription is set. Write-only schemas
+// will have a note appended to the description, as the value is not returned when reading.
 func (s *OASSchema) GetDescription() *string {
 	if s.SchemaOpts.OverrideDescription != "" {
 		return &s.SchemaOpts.OverrideDescription
 	}
 
-	if s.Schema.Description == "" {
+	description := s.Schema.Description
+	if s.IsWriteOnly() {
+		if description != "" && !strings.HasSuffix(description, ".") {
+			description += "."
+		}
+		description = strings.TrimSpace(fmt.Sprintf("%s %s", description, writeOnlyDescription))
+	}
+
+	if description == "" {
 		return nil
 	}
 
-	return &s.Schema.Description
+	return &description
+}
+
+// IsReadOnly checks the `readOnly` field, which determines if the value is only returned by the API and can't be sent in a request.
+func (s *OASSchema) IsReadOnly() bool {
+	return s.Schema.ReadOnly != nil && *s.Schema.ReadOnly
+}
+
+// IsWriteOnly checks the `writeOnly` field, which determines if the value is only sent in requests and isn't returned by the API.
+func (s *OASSchema) IsWriteOnly() bool {
+	return s.Schema.WriteOnly != nil && *s.Schema.WriteOnly
 }
 
 func (s *OASSchema) IsSensitive() *bool {
-	isSensitive := s.Format == util.OAS_format_password
+	isSensitive := s.Format == util.OAS_format_password || s.IsWriteOnly()
 
 	if !isSensitive {
 		return nil
@@ -143,6 +322,44 @@ func (s *OASSchema) GetComputability(name string) schema.ComputedOptionalRequire
 	return schema.ComputedOptional
 }
 
+// GetResourceComputability returns the computability of a resource attribute from a property schema, pSchema, of this schema:
+//   - Read-only properties are Computed, as they can't be sent in a request.
+//   - If GlobalSchemaOpts.InferComputability is set, optional properties without a default value are Optional, unless
+//     GlobalSchemaOpts.OverrideComputability is set to something other than ComputedOptional. These are only Optional if they are
+//     never returned by the API, which is decided when merging with the response attributes.
+//   - If GlobalSchemaOpts.OverrideComputability is set, like for response bodies (Computed), that is returned.
+//   - Required properties are Required.
+//   - All other optional properties are ComputedOptional.
+func (s *OASSchema) GetResourceComputability(name string, pSchema *OASSchema) schema.ComputedOptionalRequired {
+	if pSchema.IsReadOnly() {
+		return schema.Computed
+	}
+
+	isRequired := false
+	for _, prop := range s.Schema.Required {
+		if name == prop {
+			isRequired = true
+			break
+		}
+	}
+
+	override := s.GlobalSchemaOpts.OverrideComputability
+	if s.GlobalSchemaOpts.InferComputability && !isRequired && pSchema.Schema.Default == nil &&
+		(override == "" || override == schema.ComputedOptional) {
+		return schema.Optional
+	}
+
+	if override != "" {
+		return override
+	}
+
+	if isRequired {
+		return schema.Required
+	}
+
+	return schema.ComputedOptional
+}
+
 func (s *OASSchema) GetOptionalOrRequired(name string) schema.OptionalRequired {
 	for _, prop := range s.Schema.Required {
 		if name == prop {
@@ -153,10 +370,11 @@ func (s *OASSchema) GetOptionalOrRequired(name string) schema.OptionalRequired {
 	return schema.Optional
 }
 
-// IsPropertyIgnored checks if a property should be ignored
+// IsPropertyIgnored checks if a property should be ignored, recording the match in GlobalSchemaOpts.Matches
 func (s *OASSchema) IsPropertyIgnored(name string) bool {
 	for _, ignore := range s.SchemaOpts.Ignores {
 		if name == ignore {
+			s.GlobalSchemaOpts.Matches.addIgnore(s.GlobalSchemaOpts.attributePath, name)
 			return true
 		}
 	}
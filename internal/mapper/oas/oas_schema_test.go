@@ -4,11 +4,13 @@
 package oas_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
 )
 
 func pointer[T any](value T) *T {
@@ -249,3 +251,205 @@ func TestGetIgnoresForNested(t *testing.T) {
 		})
 	}
 }
+
+func TestOASSchemaGetDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   oas.OASSchema
+		expected *string
+	}{
+		"no-description": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{},
+			},
+			expected: nil,
+		},
+		"description": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Description: "hey there! I'm a description.",
+				},
+			},
+			expected: pointer("hey there! I'm a description."),
+		},
+		"override-description": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Description: "hey there! I'm a description.",
+				},
+				SchemaOpts: oas.SchemaOpts{
+					OverrideDescription: "hey there! I'm an override.",
+				},
+			},
+			expected: pointer("hey there! I'm an override."),
+		},
+		"write-only-no-description": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					WriteOnly: pointer(true),
+				},
+			},
+			expected: pointer("This attribute is write-only and is not returned by the API."),
+		},
+		"write-only-description": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Description: "hey there! I'm a description",
+					WriteOnly:   pointer(true),
+				},
+			},
+			expected: pointer("hey there! I'm a description. This attribute is write-only and is not returned by the API."),
+		},
+		"write-only-false": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Description: "hey there! I'm a description.",
+					WriteOnly:   pointer(false),
+				},
+			},
+			expected: pointer("hey there! I'm a description."),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetDescription()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOASSchema_HasProperty(t *testing.T) {
+	t.Parallel()
+
+	nestedSchema := &base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"nested_string": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	}
+
+	s, schemaErr := oas.BuildSchema(base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"string_prop": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"object_prop": base.CreateSchemaProxy(nestedSchema),
+			"array_prop": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(nestedSchema),
+				},
+			}),
+			"map_prop": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(nestedSchema),
+				},
+			}),
+			"variant_prop": base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Title: "cat",
+						Type:  []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"lives": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Title: "dog",
+						Type:  []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"bark": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+				},
+			}),
+		}),
+		AllOf: []*base.SchemaProxy{
+			base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"all_of_prop": base.CreateSchemaProxy(nestedSchema),
+				}),
+			}),
+		},
+	}), oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+	if schemaErr != nil {
+		t.Fatalf("unexpected error building schema: %s", schemaErr)
+	}
+
+	testCases := map[string]struct {
+		path     string
+		expected bool
+	}{
+		"property": {
+			path:     "string_prop",
+			expected: true,
+		},
+		"nested property": {
+			path:     "object_prop.nested_string",
+			expected: true,
+		},
+		"array items property": {
+			path:     "array_prop.nested_string",
+			expected: true,
+		},
+		"map additionalProperties property": {
+			path:     "map_prop.nested_string",
+			expected: true,
+		},
+		"allOf property": {
+			path:     "all_of_prop.nested_string",
+			expected: true,
+		},
+		"variant property": {
+			path:     "variant_prop.cat.lives",
+			expected: true,
+		},
+		"variant property without variant name": {
+			path:     "variant_prop.lives",
+			expected: false,
+		},
+		"missing property": {
+			path:     "missing_prop",
+			expected: false,
+		},
+		"missing nested property": {
+			path:     "object_prop.missing_prop",
+			expected: false,
+		},
+		"property of a non-object": {
+			path:     "string_prop.nested_string",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := s.HasProperty(strings.Split(testCase.path, "."))
+			if got != testCase.expected {
+				t.Fatalf("unexpected result for %q, got: %t, wanted: %t", testCase.path, got, testCase.expected)
+			}
+		})
+	}
+}
@@ -24,16 +24,25 @@ func (s *OASSchema) BuildObjectElementType() (schema.ElementType, *SchemaError)
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:             s.GetIgnoresForNested(name),
+			JSONStrings:         s.GetJSONStringsForNested(name),
+			ForceJSONString:     s.IsPropertyJSONString(name),
+			MultiTypeStrategies: s.GetMultiTypeStrategiesForNested(name),
 		}
 
-		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
+		pSchema, err := s.buildPropertySchema(name, pProxy, schemaOpts)
 		if err != nil {
 			return schema.ElementType{}, s.NestSchemaError(err, name)
 		}
+		if pSchema == nil {
+			continue
+		}
 
 		elemType, err := pSchema.BuildElementType()
 		if err != nil {
+			if pSchema.GlobalSchemaOpts.dropMultiTypeProperty(err) {
+				continue
+			}
 			return schema.ElementType{}, s.NestSchemaError(err, name)
 		}
 
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+const (
+	// RecursionStrategyJSON will map a recursive property that exceeds the max depth to a normalized JSON string attribute
+	RecursionStrategyJSON = "json"
+	// RecursionStrategyDrop will not map a recursive property that exceeds the max depth, and will add a warning
+	RecursionStrategyDrop = "drop"
+)
+
+var ErrRecursiveSchema = diagnostic.WithCode(diagnostic.CodeRecursiveSchema, errors.New("recursive schema exceeds max depth"))
+
+// RecursionOpts control how recursive schemas, like a tree node that contains a list of child tree nodes, are mapped.
+type RecursionOpts struct {
+	// MaxDepth is the number of times a recursive schema can be nested within itself. Defaults to 0, which means that
+	// the first recursive property will not be mapped to nested attributes.
+	MaxDepth int
+
+	// Strategy determines how a recursive property that exceeds MaxDepth is mapped, either RecursionStrategyJSON or
+	// RecursionStrategyDrop. Defaults to RecursionStrategyJSON.
+	Strategy string
+}
+
+// SchemaWarnings collects SchemaErrors that did not prevent a schema from being built, such as a recursive property that
+// was dropped. A nil SchemaWarnings will discard all warnings.
+type SchemaWarnings struct {
+	warnings []*SchemaError
+}
+
+// Add will add a warning to the collection. Warnings with the same path and message as an existing warning are not added, as the
+// same schema can be built from multiple operations.
+func (w *SchemaWarnings) Add(warning *SchemaError) {
+	if w == nil {
+		return
+	}
+
+	for _, existing := range w.warnings {
+		if existing.Path() == warning.Path() && existing.Error() == warning.Error() {
+			return
+		}
+	}
+
+	w.warnings = append(w.warnings, warning)
+}
+
+// All returns all warnings that have been added to the collection.
+func (w *SchemaWarnings) All() []*SchemaError {
+	if w == nil {
+		return nil
+	}
+
+	return w.warnings
+}
+
+// buildPropertySchema will build the schema of a property, applying the recursion strategy if the property (or the element
+// schema of a collection or map property) is a recursive schema that exceeds the max depth, and the multi-type strategy if the property
+// is an unsupported multi-type. Returns nil if the property was dropped.
+func (s *OASSchema) buildPropertySchema(name string, proxy *base.SchemaProxy, schemaOpts SchemaOpts) (*OASSchema, *SchemaError) {
+	globalOpts := s.GlobalSchemaOpts
+	globalOpts.attributePath = append(slices.Clone(s.GlobalSchemaOpts.attributePath), name)
+
+	if strategy, ok := s.SchemaOpts.MultiTypeStrategies[name]; ok {
+		globalOpts.MultiTypeStrategy = strategy
+		globalOpts.Matches.addOverride(s.GlobalSchemaOpts.attributePath, name)
+	}
+
+	// Properties that are forced to be JSON strings don't have nested attributes, so recursion isn't relevant
+	cyclePath := ""
+	if !schemaOpts.ForceJSONString {
+		cyclePath = globalOpts.recursionCyclePath(proxy)
+	}
+
+	if cyclePath == "" {
+		pSchema, err := BuildSchema(proxy, schemaOpts, globalOpts)
+		if err != nil {
+			if globalOpts.dropMultiTypeProperty(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		// Nested attributes of a read-only property can't be sent in a request either
+		if pSchema.IsReadOnly() {
+			pSchema.GlobalSchemaOpts.OverrideComputability = schema.Computed
+		}
+
+		return pSchema, nil
+	}
+
+	if globalOpts.RecursionOpts.Strategy == RecursionStrategyDrop {
+		err := fmt.Errorf("%w of %d, dropping property - cycle path: %s", ErrRecursiveSchema, globalOpts.RecursionOpts.MaxDepth, cyclePath)
+		globalOpts.Warnings.Add(newSchemaErrorFromLocation(err, s.getPropertyLocation(name), globalOpts.attributePath...))
+
+		return nil, nil
+	}
+
+	// The description and read/write-only fields of the recursive schema are preserved, but the schema will be mapped as a normalized JSON string
+	jsonSchema := &base.Schema{
+		Type: []string{util.OAS_type_string},
+	}
+	if propSchema, err := buildSchemaProxy(proxy); err == nil {
+		jsonSchema.Description = propSchema.Description
+		jsonSchema.ReadOnly = propSchema.ReadOnly
+		jsonSchema.WriteOnly = propSchema.WriteOnly
+	}
+
+	schemaOpts.ForceJSONString = true
+
+	return &OASSchema{
+		Type:             util.OAS_type_string,
+		Schema:           jsonSchema,
+		SchemaOpts:       schemaOpts,
+		GlobalSchemaOpts: globalOpts,
+	}, nil
+}
+
+// recursionCyclePath checks a property schema for recursion, following the element schemas of collection and map properties. If the
+// property is a recursive schema that exceeds the max depth, the cycle path is returned, i.e. "Node -> Node". Otherwise, returns an empty string.
+//
+// Nested object properties are not checked, as they will be checked when the nested attributes are built.
+func (g GlobalSchemaOpts) recursionCyclePath(proxy *base.SchemaProxy) string {
+	stack := g.recursionStack
+
+	for proxy != nil {
+		identity := schemaIdentity(proxy)
+		if identity != "" {
+			if recursionDepth(stack, identity) > g.RecursionOpts.MaxDepth {
+				return recursionCyclePath(stack, identity)
+			}
+
+			stack = append(slices.Clone(stack), identity)
+		}
+
+		s, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return ""
+		}
+
+		switch {
+		case s.Items != nil && s.Items.IsA():
+			proxy = s.Items.A
+		case s.AdditionalProperties != nil && s.AdditionalProperties.IsA():
+			proxy = s.AdditionalProperties.A
+		default:
+			proxy = nil
+		}
+	}
+
+	return ""
+}
+
+// pushRecursionStack adds the identity of a schema proxy to the recursion stack. If the schema proxy would exceed the max depth, a SchemaError
+// is returned. This prevents infinite recursion for any recursive schemas that aren't caught by buildPropertySchema.
+func (g GlobalSchemaOpts) pushRecursionStack(proxy *base.SchemaProxy) (GlobalSchemaOpts, *SchemaError) {
+	identity := schemaIdentity(proxy)
+	if identity == "" {
+		return g, nil
+	}
+
+	if recursionDepth(g.recursionStack, identity) > g.RecursionOpts.MaxDepth {
+		return g, SchemaErrorFromProxy(fmt.Errorf("%w of %d - cycle path: %s", ErrRecursiveSchema, g.RecursionOpts.MaxDepth, recursionCyclePath(g.recursionStack, identity)), proxy)
+	}
+
+	g.recursionStack = append(slices.Clone(g.recursionStack), identity)
+
+	return g, nil
+}
+
+// recursionDepth returns the number of times a schema identity has been nested within itself.
+func recursionDepth(stack []string, identity string) int {
+	depth := 0
+	for _, ancestor := range stack {
+		if ancestor == identity {
+			depth++
+		}
+	}
+
+	return depth
+}
+
+// recursionCyclePath returns a readable cycle path, starting from the first occurrence of the schema identity in the stack.
+func recursionCyclePath(stack []string, identity string) string {
+	start := slices.Index(stack, identity)
+	if start == -1 {
+		start = len(stack)
+	}
+
+	names := []string{}
+	for _, ancestor := range append(slices.Clone(stack[start:]), identity) {
+		names = append(names, refName(ancestor))
+	}
+
+	return strings.Join(names, " -> ")
+}
+
+// schemaIdentity returns a string that identifies a schema for detecting recursion, which is the $ref of the schema proxy. If the
+// schema proxy is not a reference, but is composed with allOf, the identities of the allOf subschemas are used instead. Returns an
+// empty string for all other inline schemas, as they can't be recursive without a reference.
+func schemaIdentity(proxy *base.SchemaProxy) string {
+	if proxy.IsReference() {
+		return proxy.GetReference()
+	}
+
+	s, err := proxy.BuildSchema()
+	if err != nil {
+		return ""
+	}
+
+	identities := []string{}
+	for _, allOfProxy := range s.AllOf {
+		identity := schemaIdentity(allOfProxy)
+		if identity != "" {
+			identities = append(identities, identity)
+		}
+	}
+
+	return strings.Join(identities, "&")
+}
+
+// refName returns the component name of a $ref, i.e. "#/components/schemas/Node" will return "Node".
+func refName(ref string) string {
+	parts := strings.Split(ref, "&")
+	for i, part := range parts {
+		parts[i] = jsonPointerUnescaper.Replace(part[strings.LastIndex(part, "/")+1:])
+	}
+
+	return strings.Join(parts, "&")
+}
@@ -16,6 +16,10 @@ type SchemaError struct {
 	err        error
 	path       []string
 	lineNumber int
+
+	// location is the source location of the schema closest to where the error occurred, which is used to find the file, column,
+	// JSON pointer, and source excerpt of the error. Can be nil if no source information exists.
+	location *sourceLocation
 }
 
 // Error implements the error interface by returning the original error string
@@ -23,16 +27,17 @@ func (e *SchemaError) Error() string {
 	return e.err.Error()
 }
 
+// Unwrap returns the original error
+func (e *SchemaError) Unwrap() error {
+	return e.err
+}
+
 // NestedSchemaError creates a new SchemaError, appending the parent name to the path. This allows a parent
 // OpenAPI schema to preserve the error and line number from a child schema, while creating a path name that is an absolute reference.
 //
 // If no line number exists for the child schema, the parent schema line number will be added.
 func (e *SchemaError) NestedSchemaError(parentName string, lineNumber int) *SchemaError {
-	newErr := &SchemaError{
-		err:        e.err,
-		path:       append([]string{parentName}, e.path...),
-		lineNumber: e.lineNumber,
-	}
+	newErr := e.nestedSchemaError(parentName, nil)
 
 	if newErr.lineNumber == 0 {
 		newErr.lineNumber = lineNumber
@@ -41,6 +46,29 @@ func (e *SchemaError) NestedSchemaError(parentName string, lineNumber int) *Sche
 	return newErr
 }
 
+// nestedSchemaError creates a new SchemaError, appending the parent name to the path. If no line number exists for the child schema,
+// the parent schema location will be used.
+func (e *SchemaError) nestedSchemaError(parentName string, parentLocation *sourceLocation) *SchemaError {
+	newErr := e.withPath(append([]string{parentName}, e.path...)...)
+
+	if newErr.lineNumber == 0 && parentLocation != nil {
+		newErr.lineNumber = parentLocation.line()
+		newErr.location = parentLocation
+	}
+
+	return newErr
+}
+
+// withPath returns a copy of the SchemaError, with the path replaced.
+func (e *SchemaError) withPath(path ...string) *SchemaError {
+	return &SchemaError{
+		err:        e.err,
+		path:       path,
+		lineNumber: e.lineNumber,
+		location:   e.location,
+	}
+}
+
 // Path returns an absolute reference to the schema where the error occurred.
 func (e *SchemaError) Path() string {
 	return strings.Join(e.path, ".")
@@ -51,6 +79,28 @@ func (e *SchemaError) LineNumber() int {
 	return e.lineNumber
 }
 
+// ColumnNumber returns the column number closest to the schema where the error occurred, or 0 if unknown.
+func (e *SchemaError) ColumnNumber() int {
+	return e.location.column()
+}
+
+// File returns the location of the file that contains the schema where the error occurred, if it's a file referenced by the
+// root OpenAPI spec file. Returns an empty string if the schema is in the root OpenAPI spec file, or the file is unknown.
+func (e *SchemaError) File() string {
+	return e.location.file()
+}
+
+// JSONPointer returns a JSON pointer to the schema where the error occurred, in the file that contains it, like
+// `#/components/schemas/Pod/properties/spec`. Returns an empty string if unknown.
+func (e *SchemaError) JSONPointer() string {
+	return e.location.jsonPointer()
+}
+
+// SourceExcerpt returns a short excerpt of the source line where the error occurred. Returns an empty string if unknown.
+func (e *SchemaError) SourceExcerpt() string {
+	return e.location.excerpt()
+}
+
 // NewSchemaError returns a new SchemaError error struct
 func NewSchemaError(err error, lineNumber int, path ...string) *SchemaError {
 	return &SchemaError{
@@ -60,6 +110,16 @@ func NewSchemaError(err error, lineNumber int, path ...string) *SchemaError {
 	}
 }
 
+// newSchemaErrorFromLocation returns a new SchemaError error struct, using a source location for the line number.
+func newSchemaErrorFromLocation(err error, location *sourceLocation, path ...string) *SchemaError {
+	return &SchemaError{
+		err:        err,
+		path:       path,
+		lineNumber: location.line(),
+		location:   location,
+	}
+}
+
 type NodeType int
 
 const (
@@ -96,16 +156,7 @@ func SchemaErrorFromNode(err error, schema *base.Schema, nodeType NodeType) *Sch
 		valueNode = low.OneOf.ValueNode
 	}
 
-	lineNumber := 0
-	if valueNode != nil {
-		lineNumber = valueNode.Line
-	}
-
-	return &SchemaError{
-		err:        err,
-		path:       make([]string, 0),
-		lineNumber: lineNumber,
-	}
+	return newSchemaErrorFromLocation(err, newSourceLocation(valueNode, low.Index))
 }
 
 // SchemaErrorFromProxy returns a new SchemaError error struct that has no path information, using a schema proxy to get the line number.
@@ -115,11 +166,10 @@ func SchemaErrorFromProxy(err error, proxy *base.SchemaProxy) *SchemaError {
 		return emptySchemaError(err)
 	}
 
-	return &SchemaError{
-		err:        err,
-		path:       make([]string, 0),
-		lineNumber: proxy.GoLow().GetValueNode().Line,
-	}
+	return newSchemaErrorFromLocation(err, &sourceLocation{
+		node:  proxy.GoLow().GetValueNode(),
+		proxy: proxy.GoLow(),
+	})
 }
 
 // emptySchemaError will return a simple SchemaError struct that contains no additional OAS information
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/pb33f/libopenapi"
+	"github.com/pb33f/libopenapi/datamodel"
+)
+
+type schemaErrorLocation struct {
+	Path          string
+	File          string
+	LineNumber    int
+	ColumnNumber  int
+	JSONPointer   string
+	SourceExcerpt string
+}
+
+func TestSchemaError_Location(t *testing.T) {
+	t.Parallel()
+
+	// Single-line JSON, like the Kubernetes OpenAPI spec
+	singleLineJSON := `{"openapi":"3.1.0","info":{"title":"Test","version":"1.0.0"},"paths":{},"components":{"schemas":{"Pod":{"type":"object","description":"A pod with a very long description, to fill up the line.","properties":{"spec":{"type":"object","properties":{"!!!":{"type":"string"}}}}}}}}`
+
+	multiFileDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(multiFileDir, "schemas.yml"), []byte(`Pod:
+  type: object
+  properties:
+    spec:
+      type: object
+      properties:
+        "!!!":
+          type: string
+`), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing test schema file: %s", err)
+	}
+
+	testCases := map[string]struct {
+		spec     string
+		config   *datamodel.DocumentConfiguration
+		expected schemaErrorLocation
+	}{
+		"single-line JSON": {
+			spec: singleLineJSON,
+			expected: schemaErrorLocation{
+				Path:          "spec.!!!",
+				LineNumber:    1,
+				ColumnNumber:  strings.Index(singleLineJSON, `{"type":"string"}`) + 1,
+				JSONPointer:   "#/components/schemas/Pod/properties/spec/properties/!!!",
+				SourceExcerpt: `...c":{"type":"object","properties":{"!!!":{"type":"string"}}}}}}}}`,
+			},
+		},
+		"multi-file YAML": {
+			spec: `openapi: 3.1.0
+info:
+  title: Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pod:
+      $ref: './schemas.yml#/Pod'
+`,
+			config: &datamodel.DocumentConfiguration{
+				BasePath:            multiFileDir,
+				AllowFileReferences: true,
+			},
+			expected: schemaErrorLocation{
+				Path:          "spec.!!!",
+				File:          filepath.Join(multiFileDir, "schemas.yml"),
+				LineNumber:    8,
+				ColumnNumber:  11,
+				JSONPointer:   "#/Pod/properties/spec/properties/!!!",
+				SourceExcerpt: "type: string",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc libopenapi.Document
+			var err error
+			if testCase.config != nil {
+				doc, err = libopenapi.NewDocumentWithConfiguration([]byte(testCase.spec), testCase.config)
+			} else {
+				doc, err = libopenapi.NewDocument([]byte(testCase.spec))
+			}
+			if err != nil {
+				t.Fatalf("unexpected error parsing test OAS: %s", err)
+			}
+
+			model, errs := doc.BuildV3Model()
+			if len(errs) > 0 {
+				t.Fatalf("unexpected error building test OAS: %s", errors.Join(errs...))
+			}
+
+			proxy, _ := model.Model.Components.Schemas.Get("Pod")
+			schema, schemaErr := oas.BuildSchema(proxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if schemaErr != nil {
+				t.Fatalf("unexpected error building schema: %s", schemaErr)
+			}
+
+			_, schemaErr = schema.BuildResourceAttributes()
+			if schemaErr == nil {
+				t.Fatalf("expected error building resource attributes")
+			}
+
+			got := schemaErrorLocation{
+				Path:          schemaErr.Path(),
+				File:          schemaErr.File(),
+				LineNumber:    schemaErr.LineNumber(),
+				ColumnNumber:  schemaErr.ColumnNumber(),
+				JSONPointer:   schemaErr.JSONPointer(),
+				SourceExcerpt: schemaErr.SourceExcerpt(),
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
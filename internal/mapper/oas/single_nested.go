@@ -17,15 +17,22 @@ func (s *OASSchema) BuildSingleNestedResource(name string, computability schema.
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	return &attrmapper.ResourceSingleNestedAttribute{
+	result := &attrmapper.ResourceSingleNestedAttribute{
 		Name:       name,
 		Attributes: objectAttributes,
 		SingleNestedAttribute: resource.SingleNestedAttribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
-	}, nil
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetObjectValidators()
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
@@ -34,15 +41,22 @@ func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schem
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	return &attrmapper.DataSourceSingleNestedAttribute{
+	result := &attrmapper.DataSourceSingleNestedAttribute{
 		Name:       name,
 		Attributes: objectAttributes,
 		SingleNestedAttribute: datasource.SingleNestedAttribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
+			Sensitive:                s.IsSensitive(),
 		},
-	}, nil
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetObjectValidators()
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildSingleNestedProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
@@ -58,6 +72,8 @@ func (s *OASSchema) BuildSingleNestedProvider(name string, optionalOrRequired sc
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Sensitive:          s.IsSensitive(),
+			Validators:         s.GetObjectValidators(),
 		},
 	}, nil
 }
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"fmt"
+	"strings"
+
+	lowbase "github.com/pb33f/libopenapi/datamodel/low/base"
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// excerptWidth is the number of characters before and after the column of a source location that are included in a source excerpt.
+const excerptWidth = 40
+
+// sourceLocation is the location of a YAML node in an OpenAPI spec file, which is used to find the file, column, JSON pointer, and
+// source excerpt of a SchemaError.
+type sourceLocation struct {
+	node *yaml.Node
+
+	// idx is the index that was used to build the schema containing the node, used to find the origin of the node.
+	idx *index.SpecIndex
+
+	// proxy is used to find the origin of the node instead of idx, when the node is the value node of a schema proxy.
+	proxy *lowbase.SchemaProxy
+}
+
+// newSourceLocation returns a sourceLocation for a node, or nil if there is no node.
+func newSourceLocation(node *yaml.Node, idx *index.SpecIndex) *sourceLocation {
+	if node == nil {
+		return nil
+	}
+
+	return &sourceLocation{
+		node: node,
+		idx:  idx,
+	}
+}
+
+// line returns the line number of the node, or 0 if there is no location.
+func (l *sourceLocation) line() int {
+	if l == nil {
+		return 0
+	}
+
+	return l.node.Line
+}
+
+// column returns the column number of the node, or 0 if there is no location.
+func (l *sourceLocation) column() int {
+	if l == nil {
+		return 0
+	}
+
+	return l.node.Column
+}
+
+// origin returns the file and index that contain the node, which can be nil if the node wasn't indexed.
+func (l *sourceLocation) origin() *index.NodeOrigin {
+	if l == nil {
+		return nil
+	}
+
+	if l.proxy != nil {
+		return l.proxy.GetSchemaReferenceLocation()
+	}
+
+	if l.idx == nil {
+		return nil
+	}
+
+	if rolodex := l.idx.GetRolodex(); rolodex != nil {
+		return rolodex.FindNodeOrigin(l.node)
+	}
+
+	return l.idx.FindNodeOrigin(l.node)
+}
+
+// file returns the absolute location of the file that contains the node, if the node is in a file referenced by the root
+// OpenAPI spec file. Returns an empty string if the node is in the root OpenAPI spec file, or the file is unknown.
+func (l *sourceLocation) file() string {
+	origin := l.origin()
+	if origin == nil || origin.Index == nil || isRootIndex(origin.Index) {
+		return ""
+	}
+
+	return origin.AbsoluteLocation
+}
+
+// jsonPointer returns the JSON pointer of the node in the file that contains it, like `#/components/schemas/Pod/properties/spec`.
+// Returns an empty string if the node isn't found.
+func (l *sourceLocation) jsonPointer() string {
+	root := l.rootNode()
+	if root == nil {
+		return ""
+	}
+
+	tokens, ok := findJSONPointer(root, l.node, []string{})
+	if !ok {
+		return ""
+	}
+
+	if len(tokens) == 0 {
+		return "#"
+	}
+
+	return fmt.Sprintf("#/%s", strings.Join(tokens, "/"))
+}
+
+// excerpt returns the source line that contains the node, shortened to the characters around the column for long lines like
+// single-line JSON files. Returns an empty string if the source isn't available.
+func (l *sourceLocation) excerpt() string {
+	if l == nil {
+		return ""
+	}
+
+	source := l.source()
+	if source == "" || l.node.Line <= 0 {
+		return ""
+	}
+
+	lines := strings.SplitN(source, "\n", l.node.Line+1)
+	if len(lines) < l.node.Line {
+		return ""
+	}
+	line := strings.TrimRight(lines[l.node.Line-1], "\r")
+
+	// Short lines, ignoring indentation, are returned in full
+	if len(strings.TrimSpace(line)) <= 2*excerptWidth {
+		return strings.TrimSpace(line)
+	}
+
+	start := max(l.node.Column-1-excerptWidth, 0)
+	end := min(l.node.Column-1+excerptWidth, len(line))
+	if start >= end {
+		return ""
+	}
+
+	excerpt := strings.TrimSpace(line[start:end])
+	if start > 0 {
+		excerpt = "..." + excerpt
+	}
+	if end < len(line) {
+		excerpt += "..."
+	}
+
+	return excerpt
+}
+
+// rootNode returns the root node of the file that contains the node.
+func (l *sourceLocation) rootNode() *yaml.Node {
+	if l == nil {
+		return nil
+	}
+
+	if origin := l.origin(); origin != nil && origin.Index != nil {
+		return origin.Index.GetRootNode()
+	}
+
+	if l.idx != nil {
+		return l.idx.GetRootNode()
+	}
+
+	return nil
+}
+
+// source returns the contents of the file that contains the node.
+func (l *sourceLocation) source() string {
+	origin := l.origin()
+
+	idx := l.idx
+	if origin != nil && origin.Index != nil {
+		idx = origin.Index
+
+		if !isRootIndex(idx) && origin.AbsoluteLocation != "" {
+			if file, err := idx.GetRolodex().Open(origin.AbsoluteLocation); err == nil && file != nil {
+				return file.GetContent()
+			}
+		}
+	}
+
+	if idx == nil || idx.GetConfig() == nil || idx.GetConfig().SpecInfo == nil || idx.GetConfig().SpecInfo.SpecBytes == nil {
+		return ""
+	}
+
+	return string(*idx.GetConfig().SpecInfo.SpecBytes)
+}
+
+// isRootIndex returns true if idx is the index of the root OpenAPI spec file.
+func isRootIndex(idx *index.SpecIndex) bool {
+	rolodex := idx.GetRolodex()
+
+	return rolodex == nil || rolodex.GetRootIndex() == nil || rolodex.GetRootIndex() == idx
+}
+
+// findJSONPointer searches current for the target node, returning the JSON pointer reference tokens of the target. A key node in a
+// mapping refers to the same location as it's value node.
+func findJSONPointer(current *yaml.Node, target *yaml.Node, tokens []string) ([]string, bool) {
+	if current == target {
+		return tokens, true
+	}
+
+	switch current.Kind {
+	case yaml.DocumentNode:
+		for _, child := range current.Content {
+			if found, ok := findJSONPointer(child, target, tokens); ok {
+				return found, true
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(current.Content); i += 2 {
+			keyNode := current.Content[i]
+			childTokens := append(tokens[:len(tokens):len(tokens)], escapeJSONPointerToken(keyNode.Value))
+			if keyNode == target {
+				return childTokens, true
+			}
+
+			if found, ok := findJSONPointer(current.Content[i+1], target, childTokens); ok {
+				return found, true
+			}
+		}
+	case yaml.SequenceNode:
+		for i, child := range current.Content {
+			childTokens := append(tokens[:len(tokens):len(tokens)], fmt.Sprintf("%d", i))
+			if found, ok := findJSONPointer(child, target, childTokens); ok {
+				return found, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// escapeJSONPointerToken escapes a JSON pointer reference token (refer to [RFC 6901]).
+//
+// [RFC 6901]: https://datatracker.ietf.org/doc/html/rfc6901#section-3
+func escapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
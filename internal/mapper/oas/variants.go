@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// jsonPointerUnescaper unescapes a single JSON pointer reference token
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// variant is a single oneOf/anyOf object subschema
+type variant struct {
+	name   string
+	proxy  *base.SchemaProxy
+	schema *base.Schema
+}
+
+// buildVariantSchema will attempt to build a polymorphic oneOf/anyOf schema into a single object schema, with one property per variant. Every
+// subschema must either be an object (that is not a map) or a "null" type, and there must be at least two object subschemas. If the subschemas
+// don't meet these requirements, nil will be returned with no error, so that the caller can attempt to resolve the subschemas another way.
+//
+// Variant properties are named from the discriminator mapping if the schema has a discriminator, otherwise from the component name of their $ref,
+// or from their title if not a reference. The returned schema retains the oneOf/anyOf keyword with just the variant property schemas, which is used
+// to identify the variant properties when building attributes.
+func buildVariantSchema(s *base.Schema, proxies []*base.SchemaProxy, nodeType NodeType) (*base.Schema, *SchemaError) {
+	keyword := "oneOf"
+	if nodeType == AnyOf {
+		keyword = "anyOf"
+	}
+
+	variants := []variant{}
+
+	for _, proxy := range proxies {
+		subschema, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		subschemaType, err := retrieveType(subschema)
+		if err != nil {
+			return nil, nil
+		}
+
+		if subschemaType == util.OAS_type_null {
+			continue
+		}
+
+		isMap := subschema.AdditionalProperties != nil && subschema.AdditionalProperties.IsA()
+		if subschemaType != util.OAS_type_object || isMap {
+			return nil, nil
+		}
+
+		variants = append(variants, variant{proxy: proxy, schema: subschema})
+	}
+
+	if len(variants) < 2 {
+		return nil, nil
+	}
+
+	variantSchema := *s
+	variantSchema.Type = []string{util.OAS_type_object}
+	variantSchema.Required = slices.Clone(s.Required)
+	variantSchema.AllOf = nil
+	variantSchema.OneOf = nil
+	variantSchema.AnyOf = nil
+	variantSchema.Properties = orderedmap.New[string, *base.SchemaProxy]()
+
+	if s.Properties != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), s.Properties) {
+			variantSchema.Properties.Set(pair.Key(), pair.Value())
+		}
+	}
+
+	for i := range variants {
+		name, err := variantName(variants[i], s.Discriminator)
+		if err != nil {
+			return nil, SchemaErrorFromProxy(diagnostic.WithCode(diagnostic.CodeInvalidVariant, fmt.Errorf("%s subschema at index %d %w", keyword, i, err)), variants[i].proxy)
+		}
+
+		if _, ok := variantSchema.Properties.Get(name); ok {
+			return nil, SchemaErrorFromProxy(diagnostic.WithCode(diagnostic.CodeInvalidVariant, fmt.Errorf("%s subschema at index %d has variant name '%s', which is already in use", keyword, i, name)), variants[i].proxy)
+		}
+
+		variants[i].name = name
+		variantSchema.Properties.Set(name, variants[i].proxy)
+	}
+
+	if s.Discriminator != nil && s.Discriminator.PropertyName != "" {
+		err := applyDiscriminator(&variantSchema, s.Discriminator, variants)
+		if err != nil {
+			return nil, SchemaErrorFromNode(err, s, nodeType)
+		}
+	}
+
+	variantProxies := []*base.SchemaProxy{}
+	for _, v := range variants {
+		variantProxy, _ := variantSchema.Properties.Get(v.name)
+		variantProxies = append(variantProxies, variantProxy)
+	}
+
+	if nodeType == AnyOf {
+		variantSchema.AnyOf = variantProxies
+	} else {
+		variantSchema.OneOf = variantProxies
+	}
+
+	return &variantSchema, nil
+}
+
+// applyDiscriminator will add the discriminator property to the variant schema as a string, with an enum of all discriminator values. The
+// discriminator property is removed from each variant, as the variant attribute that is configured will determine the discriminator value.
+func applyDiscriminator(variantSchema *base.Schema, discriminator *base.Discriminator, variants []variant) error {
+	propName := discriminator.PropertyName
+
+	if _, ok := variantSchema.Properties.Get(propName); ok {
+		return fmt.Errorf("discriminator property '%s' has the same name as a variant", propName)
+	}
+
+	discriminatorSchema := &base.Schema{
+		Type: []string{util.OAS_type_string},
+	}
+
+	// Explicit mapping values, followed by any implicit values from the variant names
+	values := []string{}
+	if discriminator.Mapping != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), discriminator.Mapping) {
+			values = append(values, pair.Key())
+		}
+	}
+
+	requiredByAll := true
+	for _, v := range variants {
+		if !slices.Contains(values, v.name) {
+			values = append(values, v.name)
+		}
+
+		if !slices.Contains(v.schema.Required, propName) {
+			requiredByAll = false
+		}
+
+		if v.schema.Properties == nil {
+			continue
+		}
+
+		propProxy, ok := v.schema.Properties.Get(propName)
+		if !ok {
+			continue
+		}
+
+		if discriminatorSchema.Description == "" {
+			propSchema, err := propProxy.BuildSchema()
+			if err == nil {
+				discriminatorSchema.Description = propSchema.Description
+			}
+		}
+
+		strippedSchema := *v.schema
+		strippedSchema.Required = slices.DeleteFunc(slices.Clone(v.schema.Required), func(required string) bool { return required == propName })
+		strippedSchema.Properties = orderedmap.New[string, *base.SchemaProxy]()
+		for pair := range orderedmap.Iterate(context.TODO(), v.schema.Properties) {
+			if pair.Key() != propName {
+				strippedSchema.Properties.Set(pair.Key(), pair.Value())
+			}
+		}
+
+		variantSchema.Properties.Set(v.name, base.CreateSchemaProxy(&strippedSchema))
+	}
+
+	for _, value := range values {
+		discriminatorSchema.Enum = append(discriminatorSchema.Enum, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+	}
+
+	variantSchema.Properties.Set(propName, base.CreateSchemaProxy(discriminatorSchema))
+
+	if requiredByAll && !slices.Contains(variantSchema.Required, propName) {
+		variantSchema.Required = append(variantSchema.Required, propName)
+	}
+
+	return nil
+}
+
+// variantName returns the name of a oneOf/anyOf variant. If a discriminator is provided, the first mapping key that references the variant
+// will be used. Otherwise, the component name of a $ref will be used, i.e. "#/components/schemas/Cat" will return "Cat", or the title of the
+// schema if it's not a reference.
+func variantName(v variant, discriminator *base.Discriminator) (string, error) {
+	componentName := ""
+	if v.proxy.IsReference() {
+		ref := v.proxy.GetReference()
+		componentName = refName(ref)
+
+		if discriminator != nil && discriminator.Mapping != nil {
+			for pair := range orderedmap.Iterate(context.TODO(), discriminator.Mapping) {
+				if pair.Value() == ref || pair.Value() == componentName {
+					return pair.Key(), nil
+				}
+			}
+		}
+	}
+
+	if componentName != "" {
+		return componentName, nil
+	}
+
+	if v.schema.Title != "" {
+		return v.schema.Title, nil
+	}
+
+	return "", fmt.Errorf("must be a $ref or have a title to be mapped as a variant")
+}
+
+// variantNames returns the property names of all oneOf/anyOf variants, if the schema was built by buildVariantSchema.
+func variantNames(s *base.Schema) []string {
+	names := []string{}
+	if s.Properties == nil || (len(s.OneOf) == 0 && len(s.AnyOf) == 0) {
+		return names
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), s.Properties) {
+		if slices.Contains(s.OneOf, pair.Value()) || slices.Contains(s.AnyOf, pair.Value()) {
+			names = append(names, pair.Key())
+		}
+	}
+
+	return names
+}
+
+// GetVariantSiblings returns the Terraform identifiers of all other oneOf/anyOf variants that are not ignored, if the property is a variant.
+// Otherwise, returns nil.
+func (s *OASSchema) GetVariantSiblings(name string) []string {
+	names := variantNames(s.Schema)
+
+	isVariant := false
+	siblings := []string{}
+	for _, variant := range names {
+		if variant == name {
+			isVariant = true
+			continue
+		}
+
+		if s.IsPropertyIgnored(variant) {
+			continue
+		}
+
+		siblings = append(siblings, util.TerraformIdentifier(variant))
+	}
+
+	if !isVariant {
+		return nil
+	}
+
+	return siblings
+}
+
+// GetObjectValidators returns the object validators for a oneOf/anyOf variant, which ensures that exactly one variant is configured.
+func (s *OASSchema) GetObjectValidators() []schema.ObjectValidator {
+	var result []schema.ObjectValidator
+
+	if len(s.SchemaOpts.ExactlyOneOf) > 0 {
+		result = append(result, schema.ObjectValidator{
+			Custom: frameworkvalidators.ObjectValidatorExactlyOneOf(s.SchemaOpts.ExactlyOneOf),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import "fmt"
+
+// Options control how the provider, resource, and data source mappers handle schemas that can't be fully mapped.
+type Options struct {
+	// Strict will fail mapping an entire schema when any property or operation response body can't be mapped. By default, a property
+	// that can't be mapped is dropped from the schema, along with all of it's nested properties, and the error is logged as a warning.
+	//
+	// In strict mode, the resource and data source mappers will also return a SkippedError for each resource or data source that
+	// was skipped, after all of them have been mapped.
+	Strict bool
+}
+
+// SkippedError is returned by a mapper in strict mode for each resource or data source that was skipped because it's schema
+// couldn't be mapped.
+type SkippedError struct {
+	// Kind is either "resource" or "data source".
+	Kind string
+
+	// Name is the name of the skipped resource or data source.
+	Name string
+
+	Err error
+}
+
+// Error implements the error interface.
+func (e *SkippedError) Error() string {
+	return fmt.Sprintf("skipped %s %q: %s", e.Kind, e.Name, e.Err)
+}
+
+// Unwrap returns the error that caused the resource or data source to be skipped.
+func (e *SkippedError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+)
+
+// jsonStringOverrides returns the attribute locations of all overrides that force an attribute to be mapped as a JSON string.
+func jsonStringOverrides(overrides map[string]explorer.Override) []string {
+	jsonStrings := []string{}
+	for key, override := range overrides {
+		if override.JSONString {
+			jsonStrings = append(jsonStrings, key)
+		}
+	}
+	sort.Strings(jsonStrings)
+
+	return jsonStrings
+}
+
+// multiTypeStrategyOverrides returns the multi-type strategies of all overrides, keyed by attribute location.
+func multiTypeStrategyOverrides(overrides map[string]explorer.Override) map[string]string {
+	multiTypeStrategies := make(map[string]string)
+	for key, override := range overrides {
+		if override.MultiType != "" {
+			multiTypeStrategies[key] = override.MultiType
+		}
+	}
+
+	return multiTypeStrategies
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// ignoredHeaderParameters are header parameters that are ignored, as they are described by other fields in the OpenAPI spec.
+//
+// Reference: https://spec.openapis.org/oas/v3.1.0#fixed-fields-9
+var ignoredHeaderParameters = []string{"Accept", "Content-Type", "Authorization"}
+
+// isParameterMapped determines if a parameter should be mapped to an attribute. Path and query parameters are always mapped,
+// header and cookie parameters are only mapped if enabled in the parameter options.
+func isParameterMapped(param *high.Parameter, paramOpts explorer.ParameterOptions) bool {
+	switch param.In {
+	case util.OAS_param_path, util.OAS_param_query:
+		return true
+	case util.OAS_param_header:
+		if !paramOpts.Headers {
+			return false
+		}
+
+		for _, ignoredHeader := range ignoredHeaderParameters {
+			if strings.EqualFold(param.Name, ignoredHeader) {
+				return false
+			}
+		}
+		return true
+	case util.OAS_param_cookie:
+		return paramOpts.Cookies
+	default:
+		return false
+	}
+}
+
+// parameterAttributeName returns the attribute name of a parameter and whether it was aliased. Aliases are matched to the
+// original parameter name, which is case-insensitive for header parameters.
+func parameterAttributeName(param *high.Parameter, aliases map[string]string) (string, bool) {
+	if key, ok := parameterAlias(param, aliases); ok {
+		return aliases[key], true
+	}
+
+	return util.ParameterName(param.Name, param.In), false
+}
+
+// parameterAlias returns the key of the alias that matches the parameter name, if any.
+func parameterAlias(param *high.Parameter, aliases map[string]string) (string, bool) {
+	if _, ok := aliases[param.Name]; ok {
+		return param.Name, true
+	}
+
+	if param.In == util.OAS_param_header {
+		for _, name := range util.SortedKeys(aliases) {
+			if strings.EqualFold(param.Name, name) {
+				return name, true
+			}
+		}
+	}
+
+	return "", false
+}
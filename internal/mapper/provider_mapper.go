@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 )
@@ -23,13 +24,15 @@ type ProviderMapper interface {
 type providerMapper struct {
 	provider explorer.Provider
 	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg  config.Config
+	opts Options
 }
 
-func NewProviderMapper(exploredProvider explorer.Provider, cfg config.Config) ProviderMapper {
+func NewProviderMapper(exploredProvider explorer.Provider, cfg config.Config, opts Options) ProviderMapper {
 	return providerMapper{
 		provider: exploredProvider,
 		cfg:      cfg,
+		opts:     opts,
 	}
 }
 
@@ -44,7 +47,7 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*provider.Provider, error)
 
 	pLogger := logger.With("provider", providerIR.Name)
 
-	providerSchema, err := generateProviderSchema(pLogger, m.provider)
+	providerSchema, err := generateProviderSchema(pLogger, m.provider, m.opts)
 	if err != nil {
 		return nil, err
 	}
@@ -53,25 +56,47 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*provider.Provider, error)
 	return &providerIR, nil
 }
 
-func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider) (*provider.Schema, error) {
-	providerSchema := &provider.Schema{}
+func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider, opts Options) (*provider.Schema, error) {
+	usage := newOptionUsage()
+	attributes, err := mapProviderAttributes(logger, exploredProvider, opts, usage)
+	if err != nil {
+		return nil, err
+	}
+
+	err = logUnusedOptions(logger, usage.unusedOptions(explorer.SchemaOptions{Ignores: exploredProvider.Ignores}, nil), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.Schema{
+		Attributes: attributes.ToSpec(),
+	}, nil
+}
 
+// mapProviderAttributes maps the provider schema to attributes. The ignores that match are recorded in usage, which can be nil.
+func mapProviderAttributes(logger *slog.Logger, exploredProvider explorer.Provider, opts Options, usage *optionUsage) (attrmapper.ProviderAttributes, error) {
 	schemaOpts := oas.SchemaOpts{
 		Ignores: exploredProvider.Ignores,
 	}
-	s, err := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, oas.GlobalSchemaOpts{})
-	if err != nil {
-		return nil, err
+	warnings := &oas.SchemaWarnings{}
+	s, schemaErr := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, oas.GlobalSchemaOpts{
+		MultiTypeStrategy: exploredProvider.MultiTypeStrategy,
+		Warnings:          warnings,
+		BestEffort:        !opts.Strict,
+		Matches:           usage.optionMatches(),
+	})
+	if schemaErr != nil {
+		return nil, schemaErr
 	}
 
-	attributes, err := s.BuildProviderAttributes()
-	if err != nil {
-		log.WarnLogOnError(logger, err, "error mapping provider schema")
+	attributes, schemaErr := s.BuildProviderAttributes()
+	if schemaErr != nil {
+		log.WarnLogOnError(logger, schemaErr, "error mapping provider schema")
 
-		return nil, fmt.Errorf("error mapping provider schema: %w", err)
+		return nil, fmt.Errorf("error mapping provider schema: %w", schemaErr)
 	}
 
-	providerSchema.Attributes = attributes.ToSpec()
+	logSchemaWarnings(logger, warnings)
 
-	return providerSchema, nil
+	return attributes, nil
 }
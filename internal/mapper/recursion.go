@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"log/slog"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+)
+
+// recursionOpts maps the explorer recursion options to the options used when building OAS schemas.
+func recursionOpts(schemaOptions explorer.SchemaOptions) oas.RecursionOpts {
+	return oas.RecursionOpts{
+		MaxDepth: schemaOptions.RecursionOptions.MaxDepth,
+		Strategy: schemaOptions.RecursionOptions.Strategy,
+	}
+}
+
+// logSchemaWarnings logs all warnings that were collected while building OAS schemas.
+func logSchemaWarnings(logger *slog.Logger, warnings *oas.SchemaWarnings) {
+	for _, warning := range warnings.All() {
+		log.WarnLogOnError(logger, warning, "dropping attribute from schema")
+	}
+}
@@ -5,9 +5,12 @@ package mapper
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
@@ -15,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
 
 var _ ResourceMapper = resourceMapper{}
@@ -26,17 +30,20 @@ type ResourceMapper interface {
 type resourceMapper struct {
 	resources map[string]explorer.Resource
 	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg  config.Config
+	opts Options
 }
 
-func NewResourceMapper(resources map[string]explorer.Resource, cfg config.Config) ResourceMapper {
+func NewResourceMapper(resources map[string]explorer.Resource, cfg config.Config, opts Options) ResourceMapper {
 	return resourceMapper{
 		resources: resources,
 		cfg:       cfg,
+		opts:      opts,
 	}
 }
 
 func (m resourceMapper) MapToIR(logger *slog.Logger) ([]resource.Resource, error) {
+	var errResult error
 	resourceSchemas := []resource.Resource{}
 
 	// Guarantee the order of processing
@@ -45,9 +52,19 @@ func (m resourceMapper) MapToIR(logger *slog.Logger) ([]resource.Resource, error
 		explorerResource := m.resources[name]
 		rLogger := logger.With("resource", name)
 
-		schema, err := generateResourceSchema(rLogger, explorerResource)
+		schema, err := generateResourceSchema(rLogger, explorerResource, m.opts)
 		if err != nil {
-			log.WarnLogOnError(rLogger, err, "skipping resource schema mapping")
+			if !m.opts.Strict {
+				log.WarnLogOnError(rLogger, err, "skipping resource schema mapping")
+				continue
+			}
+
+			// Unused options have already been logged individually, each with their own diagnostic code
+			var unusedErr *UnusedOptionError
+			if !errors.As(err, &unusedErr) {
+				log.ErrorLogOnError(rLogger, err, "skipping resource schema mapping")
+			}
+			errResult = errors.Join(errResult, &SkippedError{Kind: "resource", Name: name, Err: err})
 			continue
 		}
 
@@ -57,29 +74,129 @@ func (m resourceMapper) MapToIR(logger *slog.Logger) ([]resource.Resource, error
 		})
 	}
 
-	return resourceSchemas, nil
+	return resourceSchemas, errResult
 }
 
-func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource) (*resource.Schema, error) {
-	resourceSchema := &resource.Schema{
-		Attributes: []resource.Attribute{},
+func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource, opts Options) (*resource.Schema, error) {
+	usage := newOptionUsage()
+	resourceAttributes, err := mapResourceAttributes(logger, explorerResource, opts, usage)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceAttributes, err = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+
+	// Ignores, overrides, and aliases that didn't match anything are most likely typos, or properties renamed in the OpenAPI spec
+	err = logUnusedOptions(logger, usage.unusedOptions(explorerResource.SchemaOptions, err), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resource.Schema{
+		Attributes: resourceAttributes.ToSpec(),
+	}, nil
+}
+
+// mapResourceAttributes maps the request bodies, response bodies, and parameters of all resource operations to attributes, then
+// merges them together. Overrides are not applied. The ignores, overrides, and aliases that match are recorded in usage, which can
+// be nil.
+func mapResourceAttributes(logger *slog.Logger, explorerResource explorer.Resource, opts Options, usage *optionUsage) (attrmapper.ResourceAttributes, error) {
+	if explorerResource.CreateOp == nil {
+		return nil, diagnostic.WithCode(diagnostic.CodeMissingOperation, errors.New("no create operation found"))
 	}
 
 	// ********************
-	// Create Request Body (required)
+	// Create Request Body (required, unless the create operation has no request body)
 	// ********************
 	logger.Debug("searching for create operation request body")
 
+	warnings := &oas.SchemaWarnings{}
+	recursion := recursionOpts(explorerResource.SchemaOptions)
+	multiType := explorerResource.SchemaOptions.MultiTypeStrategy
+	jsonStrings := jsonStringOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+	multiTypeStrategies := multiTypeStrategyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+	bestEffort := !opts.Strict
+
+	var createRequestAttributes attrmapper.ResourceAttributes
+	var schemaErr *oas.SchemaError
 	schemaOpts := oas.SchemaOpts{
-		Ignores: explorerResource.SchemaOptions.Ignores,
+		Ignores:             explorerResource.SchemaOptions.Ignores,
+		JSONStrings:         jsonStrings,
+		MultiTypeStrategies: multiTypeStrategies,
+		SchemaPath:          explorerResource.CreateOpOptions.RequestPath,
+		MediaType:           explorerResource.CreateOpOptions.MediaType,
 	}
-	createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, oas.GlobalSchemaOpts{})
+	createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, oas.GlobalSchemaOpts{
+		InferComputability: true,
+		RecursionOpts:      recursion,
+		MultiTypeStrategy:  multiType,
+		Warnings:           warnings,
+		BestEffort:         bestEffort,
+		Matches:            usage.optionMatches(),
+	})
 	if err != nil {
-		return nil, err
+		if !errors.Is(err, oas.ErrSchemaNotFound) {
+			return nil, err
+		}
+
+		// Create operations without a request body (like associating two existing objects) receive all of their
+		// input via parameters, so the required parameters become the required attributes of the resource
+		logger.Info("no create operation request body found, mapping create operation parameters")
+		createRequestAttributes, err = buildResourceParameterAttributes(logger, explorerResource, "create", explorerResource.CreateOpParameters(), schema.Required, opts, warnings, usage)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		createRequestAttributes, schemaErr = createRequestSchema.BuildResourceAttributes()
+		if schemaErr != nil {
+			return nil, schemaErr
+		}
+	}
+
+	// *********************
+	// Update Request Body (optional)
+	// *********************
+	logger.Debug("searching for update operation request body")
+
+	// If the update request body can't be mapped, it's unknown which attributes can be modified in-place
+	applyRequiresReplace := true
+	updateRequestAttributes := attrmapper.ResourceAttributes{}
+	schemaOpts = oas.SchemaOpts{
+		Ignores:             explorerResource.SchemaOptions.Ignores,
+		JSONStrings:         jsonStrings,
+		MultiTypeStrategies: multiTypeStrategies,
+		SchemaPath:          explorerResource.UpdateOpOptions.RequestPath,
+		MediaType:           explorerResource.UpdateOpOptions.MediaType,
+	}
+	globalSchemaOpts := oas.GlobalSchemaOpts{
+		OverrideComputability: schema.ComputedOptional,
+		InferComputability:    true,
+		RecursionOpts:         recursion,
+		MultiTypeStrategy:     multiType,
+		Warnings:              warnings,
+		BestEffort:            bestEffort,
+		Matches:               usage.optionMatches(),
 	}
-	createRequestAttributes, schemaErr := createRequestSchema.BuildResourceAttributes()
-	if schemaErr != nil {
-		return nil, schemaErr
+	updateRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.UpdateOp, schemaOpts, globalSchemaOpts)
+	if err != nil {
+		if errors.Is(err, oas.ErrSchemaNotFound) {
+			// Demote log to INFO if there was no schema found
+			logger.Info("skipping mapping of update operation request body", "err", err)
+		} else if opts.Strict {
+			return nil, fmt.Errorf("error mapping update operation request body: %w", err)
+		} else {
+			log.WarnLogOnError(logger, err, "skipping mapping of update operation request body")
+			applyRequiresReplace = false
+		}
+	} else {
+		updateRequestAttributes, schemaErr = updateRequestSchema.BuildResourceAttributes()
+		if schemaErr != nil {
+			if opts.Strict {
+				return nil, fmt.Errorf("error mapping update operation request body: %w", schemaErr)
+			}
+			log.WarnLogOnError(logger, schemaErr, "skipping mapping of update operation request body")
+			applyRequiresReplace = false
+		}
 	}
 
 	// *********************
@@ -89,22 +206,37 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 
 	createResponseAttributes := attrmapper.ResourceAttributes{}
 	schemaOpts = oas.SchemaOpts{
-		Ignores: explorerResource.SchemaOptions.Ignores,
+		Ignores:             explorerResource.SchemaOptions.Ignores,
+		JSONStrings:         jsonStrings,
+		MultiTypeStrategies: multiTypeStrategies,
+		SchemaPath:          explorerResource.CreateOpOptions.ResponsePath,
+		ResponseCode:        explorerResource.CreateOpOptions.ResponseCode,
+		MediaType:           explorerResource.CreateOpOptions.MediaType,
 	}
-	globalSchemaOpts := oas.GlobalSchemaOpts{
+	globalSchemaOpts = oas.GlobalSchemaOpts{
 		OverrideComputability: schema.Computed,
+		RecursionOpts:         recursion,
+		MultiTypeStrategy:     multiType,
+		Warnings:              warnings,
+		BestEffort:            bestEffort,
+		Matches:               usage.optionMatches(),
 	}
 	createResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.CreateOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		if errors.Is(err, oas.ErrSchemaNotFound) {
 			// Demote log to INFO if there was no schema found
 			logger.Info("skipping mapping of create operation response body", "err", err)
+		} else if opts.Strict {
+			return nil, fmt.Errorf("error mapping create operation response body: %w", err)
 		} else {
-			logger.Warn("skipping mapping of create operation response body", "err", err)
+			log.WarnLogOnError(logger, err, "skipping mapping of create operation response body")
 		}
 	} else {
 		createResponseAttributes, schemaErr = createResponseSchema.BuildResourceAttributes()
 		if schemaErr != nil {
+			if opts.Strict {
+				return nil, fmt.Errorf("error mapping create operation response body: %w", schemaErr)
+			}
 			log.WarnLogOnError(logger, schemaErr, "skipping mapping of create operation response body")
 		}
 	}
@@ -117,22 +249,37 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	readResponseAttributes := attrmapper.ResourceAttributes{}
 
 	schemaOpts = oas.SchemaOpts{
-		Ignores: explorerResource.SchemaOptions.Ignores,
+		Ignores:             explorerResource.SchemaOptions.Ignores,
+		JSONStrings:         jsonStrings,
+		MultiTypeStrategies: multiTypeStrategies,
+		SchemaPath:          explorerResource.ReadOpOptions.ResponsePath,
+		ResponseCode:        explorerResource.ReadOpOptions.ResponseCode,
+		MediaType:           explorerResource.ReadOpOptions.MediaType,
 	}
 	globalSchemaOpts = oas.GlobalSchemaOpts{
 		OverrideComputability: schema.Computed,
+		RecursionOpts:         recursion,
+		MultiTypeStrategy:     multiType,
+		Warnings:              warnings,
+		BestEffort:            bestEffort,
+		Matches:               usage.optionMatches(),
 	}
 	readResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.ReadOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		if errors.Is(err, oas.ErrSchemaNotFound) {
 			// Demote log to INFO if there was no schema found
 			logger.Info("skipping mapping of read operation response body", "err", err)
+		} else if opts.Strict {
+			return nil, fmt.Errorf("error mapping read operation response body: %w", err)
 		} else {
-			logger.Warn("skipping mapping of read operation response body", "err", err)
+			log.WarnLogOnError(logger, err, "skipping mapping of read operation response body")
 		}
 	} else {
 		readResponseAttributes, schemaErr = readResponseSchema.BuildResourceAttributes()
 		if schemaErr != nil {
+			if opts.Strict {
+				return nil, fmt.Errorf("error mapping read operation response body: %w", schemaErr)
+			}
 			log.WarnLogOnError(logger, schemaErr, "skipping mapping of read operation response body")
 		}
 	}
@@ -140,9 +287,70 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	// ****************
 	// READ Parameters (optional)
 	// ****************
-	readParameterAttributes := attrmapper.ResourceAttributes{}
-	for _, param := range explorerResource.ReadOpParameters() {
-		if param.In != util.OAS_param_path && param.In != util.OAS_param_query {
+	readParameterAttributes, err := buildResourceParameterAttributes(logger, explorerResource, "read", explorerResource.ReadOpParameters(), schema.ComputedOptional, opts, warnings, usage)
+	if err != nil {
+		return nil, err
+	}
+
+	// ****************
+	// Create, Update, and Delete Parameters (optional)
+	// ****************
+	createParameterAttributes := attrmapper.ResourceAttributes{}
+	if createRequestSchema != nil {
+		// Create parameters have already been mapped if the create operation has no request body
+		createParameterAttributes, err = buildResourceParameterAttributes(logger, explorerResource, "create", explorerResource.CreateOpParameters(), schema.ComputedOptional, opts, warnings, usage)
+		if err != nil {
+			return nil, err
+		}
+	}
+	updateParameterAttributes, err := buildResourceParameterAttributes(logger, explorerResource, "update", explorerResource.UpdateOpParameters(), schema.ComputedOptional, opts, warnings, usage)
+	if err != nil {
+		return nil, err
+	}
+	deleteParameterAttributes, err := buildResourceParameterAttributes(logger, explorerResource, "delete", explorerResource.DeleteOpParameters(), schema.ComputedOptional, opts, warnings, usage)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create request attributes and create parameters that can't be sent in the update request body or update parameters can't
+	// be modified in-place. Without either, like when there is no update operation, all of them require replacement.
+	if applyRequiresReplace {
+		updateAttributes := append(slices.Clone(updateRequestAttributes), updateParameterAttributes...)
+		createRequestAttributes = createRequestAttributes.ApplyRequiresReplace(updateAttributes)
+		createParameterAttributes = createParameterAttributes.ApplyRequiresReplace(updateAttributes)
+	}
+
+	createRequestLineNumbers := schemaLineNumbers(createRequestSchema)
+	if createRequestSchema == nil {
+		createRequestLineNumbers = parameterLineNumbers(explorerResource.CreateOpParameters(), explorerResource.SchemaOptions.AttributeOptions.Aliases)
+	}
+
+	resourceAttributes, err := mergeAttributes(logger, explorerResource.SchemaOptions.MergeConflicts, []mergeSource[attrmapper.ResourceAttributes]{
+		{name: "create operation request body", attributes: createRequestAttributes, lookupLineNumber: createRequestLineNumbers},
+		{name: "update operation request body", attributes: updateRequestAttributes, lookupLineNumber: schemaLineNumbers(updateRequestSchema)},
+		{name: "create operation response body", response: true, attributes: createResponseAttributes, lookupLineNumber: schemaLineNumbers(createResponseSchema)},
+		{name: "read operation response body", response: true, attributes: readResponseAttributes, lookupLineNumber: schemaLineNumbers(readResponseSchema)},
+		{name: "read operation parameters", attributes: readParameterAttributes, lookupLineNumber: parameterLineNumbers(explorerResource.ReadOpParameters(), explorerResource.SchemaOptions.AttributeOptions.Aliases)},
+		{name: "create operation parameters", attributes: createParameterAttributes, lookupLineNumber: parameterLineNumbers(explorerResource.CreateOpParameters(), explorerResource.SchemaOptions.AttributeOptions.Aliases)},
+		{name: "update operation parameters", attributes: updateParameterAttributes, lookupLineNumber: parameterLineNumbers(explorerResource.UpdateOpParameters(), explorerResource.SchemaOptions.AttributeOptions.Aliases)},
+		{name: "delete operation parameters", attributes: deleteParameterAttributes, lookupLineNumber: parameterLineNumbers(explorerResource.DeleteOpParameters(), explorerResource.SchemaOptions.AttributeOptions.Aliases)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logSchemaWarnings(logger, warnings)
+
+	return resourceAttributes, nil
+}
+
+// buildResourceParameterAttributes maps the parameters of an operation to resource attributes. Required
+// parameters (and all path parameters) are mapped with requiredComputability, all other parameters are mapped as ComputedOptional.
+// Schema warnings are collected in warnings, alongside the warnings of the request and response bodies.
+func buildResourceParameterAttributes(logger *slog.Logger, explorerResource explorer.Resource, opName string, params []*high.Parameter, requiredComputability schema.ComputedOptionalRequired, opts Options, warnings *oas.SchemaWarnings, usage *optionUsage) (attrmapper.ResourceAttributes, error) {
+	parameterAttributes := attrmapper.ResourceAttributes{}
+	for _, param := range params {
+		if !isParameterMapped(param, explorerResource.SchemaOptions.ParameterOptions) {
 			continue
 		}
 
@@ -151,40 +359,54 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 			Ignores:             explorerResource.SchemaOptions.Ignores,
 			OverrideDescription: param.Description,
 		}
-		globalSchemaOpts := oas.GlobalSchemaOpts{OverrideComputability: schema.ComputedOptional}
+
+		computability := schema.ComputedOptional
+		if param.In == util.OAS_param_path || (param.Required != nil && *param.Required) {
+			computability = requiredComputability
+		}
+
+		globalSchemaOpts := oas.GlobalSchemaOpts{
+			RecursionOpts:     recursionOpts(explorerResource.SchemaOptions),
+			MultiTypeStrategy: explorerResource.SchemaOptions.MultiTypeStrategy,
+			Warnings:          warnings,
+			BestEffort:        !opts.Strict,
+			Matches:           usage.optionMatches(),
+		}
+		if computability != schema.Required {
+			globalSchemaOpts.OverrideComputability = computability
+		}
 
 		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, globalSchemaOpts)
 		if schemaErr != nil {
-			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
+			if opts.Strict {
+				return nil, fmt.Errorf("error mapping %s operation parameter %q: %w", opName, param.Name, schemaErr)
+			}
+			log.WarnLogOnError(pLogger, schemaErr, fmt.Sprintf("skipping mapping of %s operation parameter", opName))
 			continue
 		}
 
 		// Check for any aliases and replace the paramater name if found
-		paramName := param.Name
-		if aliasedName, ok := explorerResource.SchemaOptions.AttributeOptions.Aliases[param.Name]; ok {
-			pLogger = pLogger.With("param_alias", aliasedName)
-			paramName = aliasedName
+		paramName, aliased := parameterAttributeName(param, explorerResource.SchemaOptions.AttributeOptions.Aliases)
+		if aliased {
+			pLogger = pLogger.With("param_alias", paramName)
+			usage.addAlias(param, explorerResource.SchemaOptions.AttributeOptions.Aliases)
 		}
 
 		if s.IsPropertyIgnored(paramName) {
 			continue
 		}
 
-		parameterAttribute, schemaErr := s.BuildResourceAttribute(paramName, schema.ComputedOptional)
+		parameterAttribute, schemaErr := s.BuildResourceAttribute(paramName, computability)
 		if schemaErr != nil {
-			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
+			if opts.Strict {
+				return nil, fmt.Errorf("error mapping %s operation parameter %q: %w", opName, param.Name, schemaErr)
+			}
+			log.WarnLogOnError(pLogger, schemaErr, fmt.Sprintf("skipping mapping of %s operation parameter", opName))
 			continue
 		}
 
-		readParameterAttributes = append(readParameterAttributes, parameterAttribute)
+		parameterAttributes = append(parameterAttributes, parameterAttribute)
 	}
 
-	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
-	resourceAttributes, _ := createRequestAttributes.Merge(createResponseAttributes, readResponseAttributes, readParameterAttributes)
-
-	// TODO: handle error for overrides
-	resourceAttributes, _ = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
-
-	resourceSchema.Attributes = resourceAttributes.ToSpec()
-	return resourceSchema, nil
+	return parameterAttributes, nil
 }
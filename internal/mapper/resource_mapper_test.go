@@ -4,13 +4,17 @@
 package mapper_test
 
 import (
+	"bytes"
+	"errors"
 	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
@@ -18,6 +22,7 @@ import (
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 func TestResourceMapper_basic_merges(t *testing.T) {
@@ -336,7 +341,7 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 								{
 									Name: "float64_prop",
 									Float64: &resource.Float64Attribute{
-										ComputedOptionalRequired: schema.ComputedOptional,
+										ComputedOptionalRequired: schema.Optional,
 										Description:              pointer("hey this is a float64!"),
 									},
 								},
@@ -350,7 +355,7 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 												{
 													Name: "super_nested_string",
 													String: &resource.StringAttribute{
-														ComputedOptionalRequired: schema.ComputedOptional,
+														ComputedOptionalRequired: schema.Optional,
 														Description:              pointer("hey this is a string!"),
 													},
 												},
@@ -701,7 +706,7 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 				{
 					Name: "create_request_optional_create_request_only",
 					String: &resource.StringAttribute{
-						ComputedOptionalRequired: schema.ComputedOptional,
+						ComputedOptionalRequired: schema.Optional,
 					},
 				},
 				{
@@ -713,13 +718,13 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 				{
 					Name: "create_request_optional_read_parameter_optional",
 					String: &resource.StringAttribute{
-						ComputedOptionalRequired: schema.ComputedOptional,
+						ComputedOptionalRequired: schema.Optional,
 					},
 				},
 				{
 					Name: "create_request_optional_read_parameter_required",
 					String: &resource.StringAttribute{
-						ComputedOptionalRequired: schema.ComputedOptional,
+						ComputedOptionalRequired: schema.Optional,
 					},
 				},
 				{
@@ -968,14 +973,14 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 				{
 					Name: "nested_map",
 					MapNested: &resource.MapNestedAttribute{
-						ComputedOptionalRequired: schema.ComputedOptional,
+						ComputedOptionalRequired: schema.Optional,
 						Description:              pointer("hey this is a map!"),
 						NestedObject: resource.NestedAttributeObject{
 							Attributes: []resource.Attribute{
 								{
 									Name: "deep_nested_int64",
 									Int64: &resource.Int64Attribute{
-										ComputedOptionalRequired: schema.ComputedOptional,
+										ComputedOptionalRequired: schema.Optional,
 										Description:              pointer("hey this is an int64!"),
 									},
 								},
@@ -1035,6 +1040,184 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 				},
 			},
 		},
+		"computability inference and overrides": {
+			schemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"overridden_prop": {
+							Computability: "computed_optional",
+						},
+					},
+				},
+			},
+			createRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"default_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey this is an object with a server default!",
+						Default:     &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"string_prop": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					"optional_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is never returned!",
+					}),
+					"overridden_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is overridden!",
+					}),
+				}),
+			}),
+			readResponseSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"default_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"string_prop": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					"response_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			want: resource.Attributes{
+				{
+					Name: "default_prop",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: []resource.Attribute{
+							{
+								Name: "string_prop",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is an object with a server default!"),
+					},
+				},
+				{
+					Name: "optional_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Description:              pointer("hey this is never returned!"),
+					},
+				},
+				{
+					Name: "overridden_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is overridden!"),
+					},
+				},
+				{
+					Name: "response_only_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+			},
+		},
+		"optional request properties returned in a response": {
+			createRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"create_response_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					"nested_obj": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"never_returned_prop": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"boolean"},
+							}),
+							"returned_prop": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						}),
+					}),
+					"never_returned_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					"read_response_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			createResponseSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"create_response_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			readResponseSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"nested_obj": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"returned_prop": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						}),
+					}),
+					"read_response_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			want: resource.Attributes{
+				{
+					Name: "create_response_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				{
+					Name: "nested_obj",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: []resource.Attribute{
+							{
+								Name: "never_returned_prop",
+								Bool: &resource.BoolAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+							{
+								Name: "returned_prop",
+								Int64: &resource.Int64Attribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				{
+					Name: "never_returned_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+				{
+					Name: "read_response_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
@@ -1043,11 +1226,13 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 
 			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
 				"test_resource": {
-					CreateOp:      createTestCreateOp(testCase.createRequestSchema, testCase.createResponseSchema),
-					ReadOp:        createTestReadOp(testCase.readResponseSchema, testCase.readParams),
+					CreateOp: createTestCreateOp(testCase.createRequestSchema, testCase.createResponseSchema),
+					ReadOp:   createTestReadOp(testCase.readResponseSchema, testCase.readParams),
+					// Every create request attribute can be updated in-place, so none of them require replacement
+					UpdateOp:      createTestUpdateOp(testCase.createRequestSchema),
 					SchemaOptions: testCase.schemaOptions,
 				},
-			}, config.Config{})
+			}, config.Config{}, mapper.Options{})
 			got, err := mapper.MapToIR(slog.Default())
 			if err != nil {
 				t.Fatalf("unexpected error: %s", err)
@@ -1064,23 +1249,1324 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 	}
 }
 
-func createTestCreateOp(request *base.SchemaProxy, response *base.SchemaProxy) *high.Operation {
-	return &high.Operation{
-		RequestBody: &high.RequestBody{
-			Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
-				"application/json": {
-					Schema: request,
-				},
+func TestResourceMapper_update_request(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		createRequestSchema *base.SchemaProxy
+		createParams        []*high.Parameter
+		updateRequestSchema *base.SchemaProxy
+		updateParams        []*high.Parameter
+		want                resource.Attributes
+	}{
+		"merge update request and require replace": {
+			createRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"immutable_prop"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"immutable_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is a string, required and immutable!",
+					}),
+					"mutable_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"boolean"},
+						Description: "hey this is a bool!",
+					}),
+				}),
 			}),
-		},
-		Responses: &high.Responses{
-			Codes: orderedmap.ToOrderedMap(map[string]*high.Response{
-				"201": {
-					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
-						"application/json": {
-							Schema: response,
+			updateRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"mutable_prop", "update_only_prop"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"mutable_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"boolean"},
+						Description: "this one already exists, so you shouldn't see this description!",
+					}),
+					"update_only_optional_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is a string, optional and only in update!",
+					}),
+					"update_only_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Description: "hey this is an int64, only in update!",
+					}),
+				}),
+			}),
+			want: resource.Attributes{
+				{
+					Name: "immutable_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a string, required and immutable!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
 						},
+					},
+				},
+				{
+					Name: "mutable_prop",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Description:              pointer("hey this is a bool!"),
+					},
+				},
+				{
+					Name: "update_only_optional_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						Description:              pointer("hey this is a string, optional and only in update!"),
+					},
+				},
+				{
+					Name: "update_only_prop",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is an int64, only in update!"),
+					},
+				},
+			},
+		},
+		"computed attributes don't require replace": {
+			createRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"config": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"generated": base.CreateSchemaProxy(&base.Schema{
+								Type:     []string{"string"},
+								ReadOnly: pointer(true),
+							}),
+							"immutable": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					"id": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is a read-only string!",
+						ReadOnly:    pointer(true),
+					}),
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			updateRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"config": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"mutable": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
 					}),
+				}),
+			}),
+			want: resource.Attributes{
+				{
+					Name: "config",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes: []resource.Attribute{
+							{
+								Name: "generated",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.Computed,
+								},
+							},
+							{
+								Name: "immutable",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.Optional,
+									PlanModifiers: schema.StringPlanModifiers{
+										{
+											Custom: &schema.CustomPlanModifier{
+												Imports: []code.Import{
+													{
+														Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+													},
+												},
+												SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+											},
+										},
+									},
+								},
+							},
+							{
+								Name: "mutable",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.Optional,
+								},
+							},
+						},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("hey this is a read-only string!"),
+					},
+				},
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
+		"no update request": {
+			createRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"string_prop"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is a string, required!",
+					}),
+				}),
+			}),
+			want: resource.Attributes{
+				{
+					Name: "string_prop",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a string, required!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"create parameters require replace unless sent in update request": {
+			createRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			createParams: []*high.Parameter{
+				{
+					Name: "region",
+					In:   "query",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name: "notify",
+					In:   "query",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				},
+			},
+			updateRequestSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+			updateParams: []*high.Parameter{
+				{
+					Name: "notify",
+					In:   "query",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				},
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+				{
+					Name: "region",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "notify",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			createOp := createTestCreateOp(testCase.createRequestSchema, nil)
+			createOp.Parameters = testCase.createParams
+
+			var updateOp *high.Operation
+			if testCase.updateRequestSchema != nil {
+				updateOp = createTestUpdateOp(testCase.updateRequestSchema)
+				updateOp.Parameters = testCase.updateParams
+			}
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createOp,
+					ReadOp:   createTestReadOp(nil, nil),
+					UpdateOp: updateOp,
+				},
+			}, config.Config{}, mapper.Options{})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_create_without_request_body(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		createParams       []*high.Parameter
+		readResponseSchema *base.SchemaProxy
+		want               resource.Attributes
+	}{
+		"create parameters and read response": {
+			createParams: []*high.Parameter{
+				{
+					Name:        "team_id",
+					In:          "path",
+					Description: "hey this is a path param!",
+					Required:    pointer(true),
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name:        "username",
+					In:          "path",
+					Description: "hey this is a path param without required set!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name:        "role",
+					In:          "query",
+					Description: "hey this is an optional query param!",
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+				{
+					Name:     "notify",
+					In:       "query",
+					Required: pointer(true),
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				},
+				{
+					Name:     "X-Request-Id",
+					In:       "header",
+					Required: pointer(true),
+					Schema: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			},
+			readResponseSchema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"role": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "this one already exists, so you shouldn't see this description!",
+					}),
+					"state": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"string"},
+						Description: "hey this is a computed string!",
+					}),
+				}),
+			}),
+			want: resource.Attributes{
+				{
+					Name: "team_id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a path param!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "username",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a path param without required set!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "role",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is an optional query param!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "notify",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Required,
+						PlanModifiers: schema.BoolPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier",
+										},
+									},
+									SchemaDefinition: "boolplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "state",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("hey this is a computed string!"),
+					},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Parameters: testCase.createParams,
+					},
+					ReadOp: createTestReadOp(testCase.readResponseSchema, nil),
+				},
+			}, config.Config{}, mapper.Options{})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_operation_parameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource explorer.Resource
+		want     resource.Attributes
+	}{
+		"create, update, and delete parameters": {
+			resource: explorer.Resource{
+				CreateOp: createTestCreateOp(base.CreateSchemaProxy(&base.Schema{
+					Type: []string{"object"},
+					Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+						"name": base.CreateSchemaProxy(&base.Schema{
+							Type: []string{"string"},
+						}),
+					}),
+				}), nil),
+				CreateCommonParameters: []*high.Parameter{
+					{
+						Name:        "zone",
+						In:          "path",
+						Required:    pointer(true),
+						Description: "hey this is the zone from the create path!",
+						Schema: base.CreateSchemaProxy(&base.Schema{
+							Type: []string{"string"},
+						}),
+					},
+				},
+				ReadOp: createTestReadOp(nil, nil),
+				UpdateOp: &high.Operation{
+					Parameters: []*high.Parameter{
+						{
+							Name:        "zone",
+							In:          "path",
+							Required:    pointer(true),
+							Description: "this one already exists, so you shouldn't see this description!",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+						{
+							Name:        "dryRun",
+							In:          "query",
+							Description: "hey this is an aliased update query param!",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"boolean"},
+							}),
+						},
+					},
+				},
+				DeleteOp: &high.Operation{
+					Parameters: []*high.Parameter{
+						{
+							Name:        "force",
+							In:          "query",
+							Description: "hey this is a delete query param!",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"boolean"},
+							}),
+						},
+						{
+							Name: "cascade",
+							In:   "query",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"boolean"},
+							}),
+						},
+					},
+				},
+				SchemaOptions: explorer.SchemaOptions{
+					Ignores: []string{"cascade"},
+					AttributeOptions: explorer.AttributeOptions{
+						Aliases: map[string]string{
+							"dryRun": "dry_run",
+						},
+					},
+				},
+			},
+			want: resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Optional,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "zone",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is the zone from the create path!"),
+					},
+				},
+				{
+					Name: "dry_run",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is an aliased update query param!"),
+					},
+				},
+				{
+					Name: "force",
+					Bool: &resource.BoolAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey this is a delete query param!"),
+					},
+				},
+			},
+		},
+		"header parameters": {
+			resource: explorer.Resource{
+				CreateOp: &high.Operation{
+					Parameters: []*high.Parameter{
+						{
+							Name:        "X-Org-Id",
+							In:          "header",
+							Required:    pointer(true),
+							Description: "hey this is a required header!",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+						{
+							Name: "Idempotency-Key",
+							In:   "header",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+						{
+							Name: "Content-Type",
+							In:   "header",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+					},
+				},
+				ReadOp: createTestReadOp(nil, nil),
+				DeleteOp: &high.Operation{
+					Parameters: []*high.Parameter{
+						{
+							Name:        "x-org-id",
+							In:          "header",
+							Description: "this one already exists, so you shouldn't see this description!",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+					},
+				},
+				SchemaOptions: explorer.SchemaOptions{
+					Ignores: []string{"idempotency_key"},
+					AttributeOptions: explorer.AttributeOptions{
+						Aliases: map[string]string{
+							"x-org-id": "organization_id",
+						},
+					},
+					ParameterOptions: explorer.ParameterOptions{
+						Headers: true,
+					},
+				},
+			},
+			want: resource.Attributes{
+				{
+					Name: "organization_id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a required header!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": testCase.resource,
+			}, config.Config{}, mapper.Options{})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_envelopes(t *testing.T) {
+	t.Parallel()
+
+	envelope := func(name string, wrapped *base.SchemaProxy) *base.SchemaProxy {
+		return base.CreateSchemaProxy(&base.Schema{
+			Type: []string{"object"},
+			Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+				name: wrapped,
+			}),
+		})
+	}
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createTestCreateOp(
+				envelope("server", base.CreateSchemaProxy(&base.Schema{
+					Type:     []string{"object"},
+					Required: []string{"name"},
+					Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+						"name": base.CreateSchemaProxy(&base.Schema{
+							Type:        []string{"string"},
+							Description: "hey this is a string, required!",
+						}),
+					}),
+				})),
+				envelope("data", base.CreateSchemaProxy(&base.Schema{
+					Type: []string{"object"},
+					Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+						"id": base.CreateSchemaProxy(&base.Schema{
+							Type:        []string{"string"},
+							Description: "hey this is a computed string!",
+						}),
+					}),
+				})),
+			),
+			CreateOpOptions: explorer.OperationOptions{
+				RequestPath:  []string{"server"},
+				ResponsePath: []string{"data"},
+			},
+			ReadOp: createTestReadOp(nil, nil),
+		},
+	}, config.Config{}, mapper.Options{})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected only one resource, got: %d", len(got))
+	}
+
+	want := resource.Attributes{
+		{
+			Name: "name",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Required,
+				Description:              pointer("hey this is a string, required!"),
+				PlanModifiers: schema.StringPlanModifiers{
+					{
+						Custom: &schema.CustomPlanModifier{
+							Imports: []code.Import{
+								{
+									Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+								},
+							},
+							SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "id",
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.Computed,
+				Description:              pointer("hey this is a computed string!"),
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_merge_conflicts(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"id"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"string"},
+				Description: "hey this is a string id!",
+			}),
+		}),
+	})
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"integer"},
+				Description: "hey this is an integer id!",
+			}),
+		}),
+	})
+
+	testCases := map[string]struct {
+		mergeConflicts string
+		want           *resource.Attributes
+	}{
+		"default - prefer request": {
+			want: &resource.Attributes{
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a string id!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"prefer request": {
+			mergeConflicts: "prefer_request",
+			want: &resource.Attributes{
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey this is a string id!"),
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"prefer response": {
+			mergeConflicts: "prefer_response",
+			want: &resource.Attributes{
+				{
+					Name: "id",
+					Int64: &resource.Int64Attribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("hey this is an integer id!"),
+					},
+				},
+			},
+		},
+		"fail - resource is skipped": {
+			mergeConflicts: "fail",
+			want:           nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(createRequestSchema, nil),
+					ReadOp:   createTestReadOp(readResponseSchema, nil),
+					SchemaOptions: explorer.SchemaOptions{
+						MergeConflicts: testCase.mergeConflicts,
+					},
+				},
+			}, config.Config{}, mapper.Options{})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.want == nil {
+				if len(got) != 0 {
+					t.Fatalf("expected resource to be skipped, got: %d", len(got))
+				}
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, *testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_best_effort(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"nested_obj": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"!!!": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+		}),
+	})
+
+	testCases := map[string]struct {
+		opts        mapper.Options
+		want        *resource.Attributes
+		expectedErr string
+	}{
+		"default - unmappable attribute is dropped": {
+			want: &resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					Name: "nested_obj",
+					SingleNested: &resource.SingleNestedAttribute{
+						Attributes:               []resource.Attribute{},
+						ComputedOptionalRequired: schema.Optional,
+					},
+				},
+			},
+		},
+		"strict - resource is skipped": {
+			opts:        mapper.Options{Strict: true},
+			want:        nil,
+			expectedErr: `skipped resource "test_resource": '!!!' cannot be converted to a valid Terraform identifier`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var skippedErr *mapper.SkippedError
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(createRequestSchema, nil),
+					ReadOp:   createTestReadOp(nil, nil),
+					UpdateOp: createTestUpdateOp(createRequestSchema),
+				},
+			}, config.Config{}, testCase.opts)
+			got, err := mapper.MapToIR(slog.Default())
+			if testCase.expectedErr != "" {
+				if !errors.As(err, &skippedErr) {
+					t.Fatalf("expected skipped error, got: %v", err)
+				}
+				if err.Error() != testCase.expectedErr {
+					t.Errorf("expected error %q, got %q", testCase.expectedErr, err.Error())
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.want == nil {
+				if len(got) != 0 {
+					t.Fatalf("expected resource to be skipped, got: %d", len(got))
+				}
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, *testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_parameter_errors(t *testing.T) {
+	t.Parallel()
+
+	readParams := []*high.Parameter{
+		{
+			Name: "name",
+			In:   "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+		{
+			Name: "???",
+			In:   "query",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+		{
+			Name: "filter",
+			In:   "query",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"status": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+					"!!!": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+		},
+	}
+
+	testCases := map[string]struct {
+		opts        mapper.Options
+		want        *resource.Attributes
+		expectedErr string
+	}{
+		"default - unmappable parameter and nested parameter property are dropped": {
+			want: &resource.Attributes{
+				{
+					Name: "name",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						PlanModifiers: schema.StringPlanModifiers{
+							{
+								Custom: &schema.CustomPlanModifier{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+										},
+									},
+									SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "filter",
+					SingleNested: &resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Attributes: resource.Attributes{
+							{
+								Name: "status",
+								String: &resource.StringAttribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"strict - resource is skipped": {
+			opts:        mapper.Options{Strict: true},
+			want:        nil,
+			expectedErr: `skipped resource "test_resource": error mapping read operation parameter "???": '???' cannot be converted to a valid Terraform identifier`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var skippedErr *mapper.SkippedError
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"object"},
+						Required: []string{"name"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}), nil),
+					ReadOp: createTestReadOp(nil, readParams),
+				},
+			}, config.Config{}, testCase.opts)
+			got, err := mapper.MapToIR(slog.Default())
+			if testCase.expectedErr != "" {
+				if !errors.As(err, &skippedErr) {
+					t.Fatalf("expected skipped error, got: %v", err)
+				}
+				if err.Error() != testCase.expectedErr {
+					t.Errorf("expected error %q, got %q", testCase.expectedErr, err.Error())
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.want == nil {
+				if len(got) != 0 {
+					t.Fatalf("expected resource to be skipped, got: %d", len(got))
+				}
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, *testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_unused_options(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"nested_obj": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"nested_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+		}),
+	})
+	readParams := []*high.Parameter{
+		{
+			Name:     "resource_id",
+			Required: pointer(true),
+			In:       "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+	}
+	schemaOpts := explorer.SchemaOptions{
+		Ignores: []string{"nested_obj.nested_prop", "nested_obj.typo"},
+		AttributeOptions: explorer.AttributeOptions{
+			Aliases: map[string]string{
+				"resource_id": "id",
+				"missing_id":  "other_id",
+			},
+			Overrides: map[string]explorer.Override{
+				"name":         {Description: "new description"},
+				"nested_obj.x": {Description: "new description"},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		opts           mapper.Options
+		expectedLogs   []string
+		unexpectedLogs []string
+		expectedErr    string
+	}{
+		"default - unused options are warnings": {
+			expectedLogs: []string{
+				`level=WARN msg="unused generator config option" resource=test_resource oas_path=nested_obj.typo code=unused_ignore err="ignore \"nested_obj.typo\" doesn't match any property or parameter"`,
+				`level=WARN msg="unused generator config option" resource=test_resource oas_path=nested_obj.x code=unused_override err="override \"nested_obj.x\" doesn't match any attribute"`,
+				`level=WARN msg="unused generator config option" resource=test_resource param=missing_id code=unused_alias err="alias \"missing_id\" doesn't match any mapped parameter"`,
+			},
+		},
+		"strict - resource is skipped": {
+			opts: mapper.Options{Strict: true},
+			expectedLogs: []string{
+				`level=ERROR msg="unused generator config option" resource=test_resource oas_path=nested_obj.typo code=unused_ignore err="ignore \"nested_obj.typo\" doesn't match any property or parameter"`,
+				`level=ERROR msg="unused generator config option" resource=test_resource oas_path=nested_obj.x code=unused_override err="override \"nested_obj.x\" doesn't match any attribute"`,
+				`level=ERROR msg="unused generator config option" resource=test_resource param=missing_id code=unused_alias err="alias \"missing_id\" doesn't match any mapped parameter"`,
+			},
+			// Every unused option is only logged once
+			unexpectedLogs: []string{
+				`msg="skipping resource schema mapping"`,
+			},
+			expectedErr: "skipped resource \"test_resource\": ignore \"nested_obj.typo\" doesn't match any property or parameter\n" +
+				"override \"nested_obj.x\" doesn't match any attribute\n" +
+				"alias \"missing_id\" doesn't match any mapped parameter",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+					if attr.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return attr
+				},
+			}))
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp:      createTestCreateOp(createRequestSchema, nil),
+					ReadOp:        createTestReadOp(nil, readParams),
+					SchemaOptions: schemaOpts,
+				},
+			}, config.Config{}, testCase.opts)
+			_, err := mapper.MapToIR(logger)
+			if testCase.expectedErr != "" {
+				if err == nil || err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %v", testCase.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, expectedLog := range testCase.expectedLogs {
+				if !strings.Contains(logs.String(), expectedLog) {
+					t.Errorf("expected log %q, got:\n%s", expectedLog, logs.String())
+				}
+			}
+
+			for _, unexpectedLog := range testCase.unexpectedLogs {
+				if strings.Contains(logs.String(), unexpectedLog) {
+					t.Errorf("unexpected log %q, got:\n%s", unexpectedLog, logs.String())
+				}
+			}
+		})
+	}
+}
+
+func createTestCreateOp(request *base.SchemaProxy, response *base.SchemaProxy) *high.Operation {
+	return &high.Operation{
+		RequestBody: &high.RequestBody{
+			Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+				"application/json": {
+					Schema: request,
+				},
+			}),
+		},
+		Responses: &high.Responses{
+			Codes: orderedmap.ToOrderedMap(map[string]*high.Response{
+				"201": {
+					Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+						"application/json": {
+							Schema: response,
+						},
+					}),
+				},
+			}),
+		},
+	}
+}
+
+func createTestUpdateOp(request *base.SchemaProxy) *high.Operation {
+	return &high.Operation{
+		RequestBody: &high.RequestBody{
+			Content: orderedmap.ToOrderedMap(map[string]*high.MediaType{
+				"application/json": {
+					Schema: request,
 				},
 			}),
 		},
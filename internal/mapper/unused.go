@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diagnostic"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+const (
+	optionIgnore   = "ignore"
+	optionOverride = "override"
+	optionAlias    = "alias"
+)
+
+// UnusedOptionError is returned for an ignore, override, or alias of a resource or data source that didn't match anything while
+// mapping, like an override with a typo in the attribute path, or an ignore for a property that was renamed in the OpenAPI spec.
+type UnusedOptionError struct {
+	// Option is either "ignore", "override", or "alias".
+	Option string
+
+	// Key is the ignore, or the key of the override or alias.
+	Key string
+}
+
+// Error implements the error interface.
+func (e *UnusedOptionError) Error() string {
+	switch e.Option {
+	case optionIgnore:
+		return fmt.Sprintf("ignore %q doesn't match any property or parameter", e.Key)
+	case optionOverride:
+		return fmt.Sprintf("override %q doesn't match any attribute", e.Key)
+	default:
+		return fmt.Sprintf("alias %q doesn't match any mapped parameter", e.Key)
+	}
+}
+
+// optionUsage records which ignores, overrides, and aliases of a resource or data source matched while mapping. A nil optionUsage
+// doesn't record anything.
+type optionUsage struct {
+	// matches records the ignores, and the overrides applied while building schemas
+	matches *oas.OptionMatches
+
+	// aliases records the keys of aliases that matched a mapped parameter
+	aliases map[string]bool
+}
+
+func newOptionUsage() *optionUsage {
+	return &optionUsage{
+		matches: oas.NewOptionMatches(),
+		aliases: make(map[string]bool),
+	}
+}
+
+// optionMatches returns the oas.OptionMatches used when building schemas.
+func (u *optionUsage) optionMatches() *oas.OptionMatches {
+	if u == nil {
+		return nil
+	}
+
+	return u.matches
+}
+
+// addAlias records the alias of a mapped parameter, if it has one.
+func (u *optionUsage) addAlias(param *high.Parameter, aliases map[string]string) {
+	if u == nil {
+		return
+	}
+
+	if key, ok := parameterAlias(param, aliases); ok {
+		u.aliases[key] = true
+	}
+}
+
+// unusedOptions returns an UnusedOptionError for every ignore, override, and alias in schemaOpts that didn't match anything, where
+// overridesErr is the error returned by ApplyOverrides. Every error has a diagnostic code.
+func (u *optionUsage) unusedOptions(schemaOpts explorer.SchemaOptions, overridesErr error) []error {
+	errs := []error{}
+
+	for _, ignore := range schemaOpts.Ignores {
+		if !u.matches.IgnoreMatched(ignore) {
+			errs = append(errs, diagnostic.WithCode(diagnostic.CodeUnusedIgnore, &UnusedOptionError{Option: optionIgnore, Key: ignore}))
+		}
+	}
+
+	notFound := make(map[string]bool)
+	for _, notFoundErr := range attrmapper.OverridesNotFound(overridesErr) {
+		notFound[strings.Join(notFoundErr.Path, ".")] = true
+	}
+	for _, key := range util.SortedKeys(notFound) {
+		// JSON string and multi-type overrides use property names, and a multi-type override can drop the attribute
+		if u.matches.OverrideMatched(key) {
+			continue
+		}
+
+		errs = append(errs, diagnostic.WithCode(diagnostic.CodeUnusedOverride, &UnusedOptionError{Option: optionOverride, Key: key}))
+	}
+
+	for _, key := range util.SortedKeys(schemaOpts.AttributeOptions.Aliases) {
+		if !u.aliases[key] {
+			errs = append(errs, diagnostic.WithCode(diagnostic.CodeUnusedAlias, &UnusedOptionError{Option: optionAlias, Key: key}))
+		}
+	}
+
+	return errs
+}
+
+// logUnusedOptions logs every unused option as a warning, or as an error in strict mode, returning all of them joined together in
+// strict mode.
+func logUnusedOptions(logger *slog.Logger, unused []error, opts Options) error {
+	var errResult error
+
+	for _, err := range unused {
+		uLogger := logger
+		var unusedErr *UnusedOptionError
+		if errors.As(err, &unusedErr) {
+			if unusedErr.Option == optionAlias {
+				uLogger = logger.With("param", unusedErr.Key)
+			} else {
+				uLogger = logger.With("oas_path", unusedErr.Key)
+			}
+		}
+
+		if !opts.Strict {
+			log.WarnLogOnError(uLogger, err, "unused generator config option")
+			continue
+		}
+
+		log.ErrorLogOnError(uLogger, err, "unused generator config option")
+		errResult = errors.Join(errResult, err)
+	}
+
+	return errResult
+}
@@ -26,13 +26,16 @@ const (
 	OAS_format_float    = "float"
 	OAS_format_password = "password"
 
-	OAS_param_path  = "path"
-	OAS_param_query = "query"
+	OAS_param_path   = "path"
+	OAS_param_query  = "query"
+	OAS_param_header = "header"
+	OAS_param_cookie = "cookie"
 
 	// Custom format for SetNested and Set attributes
 	TF_format_set = "set"
 
-	OAS_mediatype_json = "application/json"
+	OAS_mediatype_json        = "application/json"
+	OAS_mediatype_json_suffix = "+json"
 
 	OAS_response_code_ok      = "200"
 	OAS_response_code_created = "201"
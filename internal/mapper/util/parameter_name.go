@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"regexp"
+	"strings"
+)
+
+// customHeaderPrefix matches the "X-" prefix that is commonly used for non-standard HTTP headers, case-insensitive
+var customHeaderPrefix = regexp.MustCompile(`(?i)^x-`)
+
+// ParameterName converts the name of an OpenAPI parameter into an attribute name, based on the location of the parameter.
+//   - Header parameters have any "X-" prefix removed, hyphens replaced with underscores, and are lowercased: X-Org-Id = org_id
+//   - Cookie parameters have hyphens replaced with underscores: session-id = session_id
+//   - All other parameters are returned unchanged
+func ParameterName(name string, location string) string {
+	switch location {
+	case OAS_param_header:
+		withoutPrefix := customHeaderPrefix.ReplaceAllString(name, "")
+		return strings.ToLower(strings.ReplaceAll(withoutPrefix, "-", "_"))
+	case OAS_param_cookie:
+		return strings.ReplaceAll(name, "-", "_")
+	default:
+		return name
+	}
+}
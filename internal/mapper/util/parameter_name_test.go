@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+func TestParameterName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		location string
+		want     string
+	}{
+		"path - no change": {
+			name:     "org-id",
+			location: "path",
+			want:     "org-id",
+		},
+		"query - no change": {
+			name:     "dryRun",
+			location: "query",
+			want:     "dryRun",
+		},
+		"header - custom prefix removed": {
+			name:     "X-Org-Id",
+			location: "header",
+			want:     "org_id",
+		},
+		"header - lowercase custom prefix removed": {
+			name:     "x-api-version",
+			location: "header",
+			want:     "api_version",
+		},
+		"header - no custom prefix": {
+			name:     "Idempotency-Key",
+			location: "header",
+			want:     "idempotency_key",
+		},
+		"header - prefix only removed at start": {
+			name:     "Max-X-Count",
+			location: "header",
+			want:     "max_x_count",
+		},
+		"cookie - hyphens replaced": {
+			name:     "session-id",
+			location: "cookie",
+			want:     "session_id",
+		},
+		"cookie - x prefix not removed": {
+			name:     "X-Token",
+			location: "cookie",
+			want:     "X_Token",
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.ParameterName(testCase.name, testCase.location)
+			if got != testCase.want {
+				t.Errorf("expected %q, got %q", testCase.want, got)
+			}
+		})
+	}
+}
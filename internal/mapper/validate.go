@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+// ValidateResources checks the ignores, overrides, and aliases of every resource against the OpenAPI spec, returning a joined error
+// with every ignore, override, or alias that doesn't match anything. Resources are mapped to check the overrides, but nothing is
+// logged while mapping.
+func ValidateResources(resources map[string]explorer.Resource) error {
+	var errResult error
+
+	for _, name := range util.SortedKeys(resources) {
+		for _, err := range validateResource(resources[name]) {
+			errResult = errors.Join(errResult, fmt.Errorf("resource '%s' %w", name, err))
+		}
+	}
+
+	return errResult
+}
+
+// ValidateDataSources checks the ignores, overrides, and aliases of every data source against the OpenAPI spec, returning a joined
+// error with every ignore, override, or alias that doesn't match anything. Data sources are mapped to check the overrides, but
+// nothing is logged while mapping.
+func ValidateDataSources(dataSources map[string]explorer.DataSource) error {
+	var errResult error
+
+	for _, name := range util.SortedKeys(dataSources) {
+		for _, err := range validateDataSource(name, dataSources[name]) {
+			errResult = errors.Join(errResult, fmt.Errorf("data_source '%s' %w", name, err))
+		}
+	}
+
+	return errResult
+}
+
+// ValidateProvider checks the ignores of the provider against the provider schema, returning a joined error with every ignore that
+// doesn't match a property. The provider schema is mapped to check the ignores, but nothing is logged while mapping.
+func ValidateProvider(exploredProvider explorer.Provider) error {
+	if exploredProvider.SchemaProxy == nil {
+		return nil
+	}
+
+	var errResult error
+
+	usage := newOptionUsage()
+	_, err := mapProviderAttributes(discardLogger(), exploredProvider, Options{}, usage)
+	if err != nil {
+		return fmt.Errorf("provider '%s' error mapping schema to check ignores: %w", exploredProvider.Name, err)
+	}
+
+	for _, err := range usage.unusedOptions(explorer.SchemaOptions{Ignores: exploredProvider.Ignores}, nil) {
+		errResult = errors.Join(errResult, fmt.Errorf("provider '%s' %w", exploredProvider.Name, err))
+	}
+
+	return errResult
+}
+
+// validateResource maps the resource like the generate command, returning the ignores, overrides, and aliases that didn't match
+// anything while mapping.
+func validateResource(explorerResource explorer.Resource) []error {
+	usage := newOptionUsage()
+	resourceAttributes, err := mapResourceAttributes(discardLogger(), explorerResource, Options{}, usage)
+	if err != nil {
+		return []error{fmt.Errorf("error mapping attributes to check options: %w", err)}
+	}
+
+	_, err = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+
+	return usage.unusedOptions(explorerResource.SchemaOptions, err)
+}
+
+// validateDataSource maps the data source like the generate command, returning the ignores, overrides, and aliases that didn't
+// match anything while mapping.
+func validateDataSource(name string, dataSource explorer.DataSource) []error {
+	usage := newOptionUsage()
+	dataSourceAttributes, err := mapDataSourceAttributes(discardLogger(), name, dataSource, Options{}, usage)
+	if err != nil {
+		return []error{fmt.Errorf("error mapping attributes to check options: %w", err)}
+	}
+
+	_, err = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
+
+	return usage.unusedOptions(dataSource.SchemaOptions, err)
+}
+
+// discardLogger returns a logger that drops all log records, used when mapping attributes only to validate them.
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}